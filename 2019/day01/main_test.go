@@ -1,10 +1,17 @@
-package main
+package day01
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
-var example = ``
+var input = inputs.Load()
+
+var example = `12
+14
+1969
+100756`
 
 func Test_part1(t *testing.T) {
 	tests := []struct {
@@ -15,7 +22,7 @@ func Test_part1(t *testing.T) {
 		{
 			name:  "example",
 			input: example,
-			want:  0,
+			want:  34241,
 		},
 		// {
 		// 	name:  "actual",
@@ -25,6 +32,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -41,7 +49,7 @@ func Test_part2(t *testing.T) {
 		{
 			name:  "example",
 			input: example,
-			want:  0,
+			want:  51316,
 		},
 		// {
 		// 	name:  "actual",
@@ -51,6 +59,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,41 +1,14 @@
-package main
+package day02
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
 	"strings"
 
 	"github.com/zMoooooritz/advent-of-code/cast"
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2019, 2, solution.Funcs(part1, part2))
 }
 
 func part1(input string) int {
@@ -1,12 +1,15 @@
-package main
+package day02
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
-var example = `1,1,1,4,99,5,6,0,99`
+var input = inputs.Load()
 
-func Test_part1(t *testing.T) {
+// the examples are too short for the noun and verb of the parts
+func Test_runInstructions(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
@@ -14,9 +17,30 @@ func Test_part1(t *testing.T) {
 	}{
 		{
 			name:  "example",
-			input: example,
-			want:  0,
+			input: "1,9,10,3,2,3,11,0,99,30,40,50",
+			want:  3500,
+		},
+		{
+			name:  "example2",
+			input: "1,1,1,4,99,5,6,0,99",
+			want:  30,
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := runInstructions(parseInput(tt.input)); got != tt.want {
+				t.Errorf("runInstructions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_part1(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
 		// {
 		// 	name:  "actual",
 		// 	input: input,
@@ -25,6 +49,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -38,11 +63,6 @@ func Test_part2(t *testing.T) {
 		input string
 		want  int
 	}{
-		{
-			name:  "example",
-			input: example,
-			want:  0,
-		},
 		// {
 		// 	name:  "actual",
 		// 	input: input,
@@ -51,6 +71,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,26 +1,16 @@
-package main
+package day03
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
 	"image"
 	"strings"
 
 	"github.com/zMoooooritz/advent-of-code/cast"
 	"github.com/zMoooooritz/advent-of-code/maths"
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
+	solution.Register(2019, 3, solution.Funcs(part1, part2))
 }
 
 type Direction int
@@ -37,23 +27,6 @@ type Instruction struct {
 	Distance  int
 }
 
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
-}
-
 func part1(input string) int {
 	first, second := parseInput(input)
 
@@ -1,9 +1,13 @@
-package main
+package day03
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `R75,D30,R83,U83,L12,D49,R71,U7,L72
 U62,R66,U55,R34,D71,R55,D58,R83`
 
@@ -26,6 +30,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -52,6 +57,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,10 +1,12 @@
-package main
+package day04
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
-var example = `125730-579381`
+var input = inputs.Load()
 
 func Test_part1(t *testing.T) {
 	tests := []struct {
@@ -13,8 +15,18 @@ func Test_part1(t *testing.T) {
 		want  int
 	}{
 		{
-			name:  "example",
-			input: example,
+			name:  "111111",
+			input: "111111-111111",
+			want:  1,
+		},
+		{
+			name:  "223450",
+			input: "223450-223450",
+			want:  0,
+		},
+		{
+			name:  "123789",
+			input: "123789-123789",
 			want:  0,
 		},
 		// {
@@ -25,6 +37,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -39,10 +52,20 @@ func Test_part2(t *testing.T) {
 		want  int
 	}{
 		{
-			name:  "example",
-			input: example,
+			name:  "112233",
+			input: "112233-112233",
+			want:  1,
+		},
+		{
+			name:  "123444",
+			input: "123444-123444",
 			want:  0,
 		},
+		{
+			name:  "111122",
+			input: "111122-111122",
+			want:  1,
+		},
 		// {
 		// 	name:  "actual",
 		// 	input: input,
@@ -51,6 +74,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,9 +1,13 @@
-package main
+package day05
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = ``
 
 func Test_part1(t *testing.T) {
@@ -61,6 +65,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -87,6 +92,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,40 +1,13 @@
-package main
+package day08
 
 import (
-	_ "embed"
-	"flag"
 	"fmt"
-	"strings"
 
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2019, 8, solution.Funcs(part1, part2))
 }
 
 type Layer struct {
@@ -55,15 +28,12 @@ func (l *Layer) countOcc(target int) int {
 }
 
 func part1(input string) int {
-	test := false
-
-	width := 25
-	height := 6
-	if test {
-		width = 3
-		height = 2
-	}
+	return checksum(input, 25, 6)
+}
 
+// checksum multiplies the 1 and 2 digits of the layer with the fewest 0
+// digits, the example image is 3x2 instead of 25x6
+func checksum(input string, width, height int) int {
 	layers := parseInput(input, width, height)
 
 	minCount := 99999
@@ -102,15 +72,12 @@ func overlayLayers(layers []Layer) Layer {
 }
 
 func part2(input string) int {
-	test := false
-
-	width := 25
-	height := 6
-	if test {
-		width = 2
-		height = 2
-	}
+	return decode(input, 25, 6)
+}
 
+// decode prints the image made of the layers, the example image is 2x2
+// instead of 25x6
+func decode(input string, width, height int) int {
 	layers := parseInput(input, width, height)
 	layer := overlayLayers(layers)
 
@@ -1,31 +1,42 @@
-package main
+package day08
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `123456789012`
 
 func Test_part1(t *testing.T) {
 	tests := []struct {
-		name  string
-		input string
-		want  int
+		name   string
+		input  string
+		width  int
+		height int
+		want   int
 	}{
 		{
-			name:  "example",
-			input: example,
-			want:  0,
+			name:   "example",
+			input:  example,
+			width:  3,
+			height: 2,
+			want:   1,
 		},
 		// {
-		// 	name:  "actual",
-		// 	input: input,
-		// 	want:  0,
+		// 	name:   "actual",
+		// 	input:  input,
+		// 	width:  25,
+		// 	height: 6,
+		// 	want:   0,
 		// },
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part1(tt.input); got != tt.want {
+			inputs.SkipMissing(t, tt.input)
+			if got := checksum(tt.input, tt.width, tt.height); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
 		})
@@ -34,24 +45,31 @@ func Test_part1(t *testing.T) {
 
 func Test_part2(t *testing.T) {
 	tests := []struct {
-		name  string
-		input string
-		want  int
+		name   string
+		input  string
+		width  int
+		height int
+		want   int
 	}{
 		{
-			name:  "example",
-			input: "0222112222120000",
-			want:  0,
+			name:   "example",
+			input:  "0222112222120000",
+			width:  2,
+			height: 2,
+			want:   0,
 		},
 		// {
-		// 	name:  "actual",
-		// 	input: input,
-		// 	want:  0,
+		// 	name:   "actual",
+		// 	input:  input,
+		// 	width:  25,
+		// 	height: 6,
+		// 	want:   0,
 		// },
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part2(tt.input); got != tt.want {
+			inputs.SkipMissing(t, tt.input)
+			if got := decode(tt.input, tt.width, tt.height); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
 		})
@@ -1,40 +1,13 @@
-package main
+package day10
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
 	"strings"
 
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2023, 10, solution.Funcs(part1, part2))
 }
 
 type Position struct {
@@ -155,7 +128,7 @@ func startPosition(data [][]rune, start Position) Position {
 			return Position{start.X, start.Y - 1}
 		}
 	}
-	if start.X < len(data)-1 {
+	if start.Y < len(data)-1 {
 		below := data[start.Y+1][start.X]
 		if below == '|' || below == 'L' || below == 'J' {
 			return Position{start.X, start.Y + 1}
@@ -164,13 +137,19 @@ func startPosition(data [][]rune, start Position) Position {
 	return Position{}
 }
 
-// does not work if s is at the border
-// too lazy to implement this here
+// replaceStart replaces S by the pipe connecting its neighbours, outside of
+// the grid there is only ground
 func replaceStart(data [][]rune, start Position) {
-	above := data[start.Y-1][start.X]
-	below := data[start.Y+1][start.X]
-	right := data[start.Y][start.X+1]
-	left := data[start.Y][start.X-1]
+	at := func(x, y int) rune {
+		if y < 0 || y >= len(data) || x < 0 || x >= len(data[y]) {
+			return '.'
+		}
+		return data[y][x]
+	}
+	above := at(start.X, start.Y-1)
+	below := at(start.X, start.Y+1)
+	right := at(start.X+1, start.Y)
+	left := at(start.X-1, start.Y)
 
 	if above == '|' || above == '7' || above == 'F' {
 		if right == '-' || right == 'J' || right == '7' {
@@ -1,9 +1,13 @@
-package main
+package day17
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `2413432311323
 3215453535623
 3255245654254
@@ -18,6 +22,12 @@ var example = `2413432311323
 2546548887735
 4322674655533`
 
+var example2 = `111111111111
+999999999991
+999999999991
+999999999991
+999999999991`
+
 func Test_part1(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -37,6 +47,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -53,6 +64,11 @@ func Test_part2(t *testing.T) {
 		{
 			name:  "example",
 			input: example,
+			want:  94,
+		},
+		{
+			name:  "example2",
+			input: example2,
 			want:  71,
 		},
 		// {
@@ -63,6 +79,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
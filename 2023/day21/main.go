@@ -1,42 +1,15 @@
-package main
+package day21
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
 	"image"
 	"strings"
 
 	"github.com/zMoooooritz/advent-of-code/ds/pq"
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2023, 21, solution.Funcs(part1, part2))
 }
 
 type Tile int
@@ -53,9 +26,15 @@ type State struct {
 }
 
 func part1(input string) int {
+	return reachable(input, 64)
+}
+
+// reachable counts the plots reachable in exactly the given steps, the
+// example uses 6 steps instead of 64
+func reachable(input string, steps int) int {
 	tiles := parseInput(input)
 
-	return bfs(tiles, 64)
+	return bfs(tiles, steps)
 }
 
 func bfs(tiles [][]Tile, steps int) int {
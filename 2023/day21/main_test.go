@@ -1,9 +1,13 @@
-package main
+package day21
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `...........
 .....###.#.
 .###.##..#.
@@ -20,22 +24,26 @@ func Test_part1(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
+		steps int
 		want  int
 	}{
 		{
 			name:  "example",
 			input: example,
+			steps: 6,
 			want:  16,
 		},
 		// {
 		// 	name:  "actual",
 		// 	input: input,
+		// 	steps: 64,
 		// 	want:  0,
 		// },
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part1(tt.input); got != tt.want {
+			inputs.SkipMissing(t, tt.input)
+			if got := reachable(tt.input, tt.steps); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
 		})
@@ -61,6 +69,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,42 +1,15 @@
-package main
+package day23
 
 import (
 	"container/list"
-	_ "embed"
-	"flag"
-	"fmt"
 	"image"
 	"strings"
 
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2023, 23, solution.Funcs(part1, part2))
 }
 
 type Tile int
@@ -140,7 +113,94 @@ func findLongestPath(tiles [][]Tile) int {
 }
 
 func part2(input string) int {
-	return 0
+	tiles := parseInput(input)
+
+	return findLongestHike(tiles)
+}
+
+type Edge struct {
+	To       int
+	Distance int
+}
+
+// findLongestHike treats slopes as paths, the corridors between junctions
+// are contracted to edges so only the junctions are searched
+func findLongestHike(tiles [][]Tile) int {
+	open := func(p image.Point) bool {
+		return p.Y >= 0 && p.Y < len(tiles) && p.X >= 0 && p.X < len(tiles[p.Y]) && tiles[p.Y][p.X] != FOREST && tiles[p.Y][p.X] != NONE
+	}
+	directions := []image.Point{{0, 1}, {0, -1}, {1, 0}, {-1, 0}}
+	neighbours := func(p image.Point) []image.Point {
+		result := []image.Point{}
+		for _, dir := range directions {
+			if n := p.Add(dir); open(n) {
+				result = append(result, n)
+			}
+		}
+		return result
+	}
+
+	junctions := map[image.Point]int{}
+	var start, end image.Point
+	for y, line := range tiles {
+		for x, v := range line {
+			p := image.Point{x, y}
+			if v == START {
+				start = p
+			}
+			if v == END {
+				end = p
+			}
+			if v == START || v == END || (open(p) && len(neighbours(p)) > 2) {
+				junctions[p] = len(junctions)
+			}
+		}
+	}
+
+	edges := make([][]Edge, len(junctions))
+	for junction, index := range junctions {
+		for _, next := range neighbours(junction) {
+			prev, distance := junction, 1
+			for {
+				if to, ok := junctions[next]; ok {
+					edges[index] = append(edges[index], Edge{to, distance})
+					break
+				}
+				forward := []image.Point{}
+				for _, n := range neighbours(next) {
+					if n != prev {
+						forward = append(forward, n)
+					}
+				}
+				if len(forward) == 0 {
+					break
+				}
+				prev, next = next, forward[0]
+				distance++
+			}
+		}
+	}
+
+	seen := make([]bool, len(junctions))
+	var dfs func(node int) int
+	dfs = func(node int) int {
+		if node == junctions[end] {
+			return 0
+		}
+		seen[node] = true
+		longest := -1
+		for _, edge := range edges[node] {
+			if seen[edge.To] {
+				continue
+			}
+			if rest := dfs(edge.To); rest >= 0 {
+				longest = max(longest, rest+edge.Distance)
+			}
+		}
+		seen[node] = false
+		return longest
+	}
+	return dfs(junctions[start])
 }
 
 func isSlopeTile(tile Tile) bool {
@@ -1,42 +1,16 @@
-package main
+package day14
 
 import (
-	_ "embed"
-	"flag"
 	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/zMoooooritz/advent-of-code/cast"
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2024, 14, solution.Funcs(part1, part2))
 }
 
 type Coordinate struct {
@@ -67,8 +41,14 @@ var width = 0
 var height = 0
 
 func part1(input string) int {
-	width = 101
-	height = 103
+	return safetyFactor(input, 101, 103)
+}
+
+// safetyFactor moves the robots 100 times on a grid of the given size, the
+// example uses 11x7 instead of 101x103
+func safetyFactor(input string, w, h int) int {
+	width = w
+	height = h
 
 	robots := parseInput(input)
 
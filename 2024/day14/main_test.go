@@ -1,9 +1,13 @@
-package main
+package day14
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `p=0,4 v=3,-3
 p=6,3 v=-1,-3
 p=10,3 v=-1,2
@@ -19,24 +23,31 @@ p=9,5 v=-3,-3`
 
 func Test_part1(t *testing.T) {
 	tests := []struct {
-		name  string
-		input string
-		want  int
+		name   string
+		input  string
+		width  int
+		height int
+		want   int
 	}{
 		{
-			name:  "example",
-			input: example,
-			want:  12,
+			name:   "example",
+			input:  example,
+			width:  11,
+			height: 7,
+			want:   12,
 		},
 		// {
-		// 	name:  "actual",
-		// 	input: input,
-		// 	want:  0,
+		// 	name:   "actual",
+		// 	input:  input,
+		// 	width:  101,
+		// 	height: 103,
+		// 	want:   0,
 		// },
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part1(tt.input); got != tt.want {
+			inputs.SkipMissing(t, tt.input)
+			if got := safetyFactor(tt.input, tt.width, tt.height); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
 		})
@@ -62,6 +73,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,10 +1,19 @@
-package main
+package day17
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
-var example = `Register A: 0
+var input = inputs.Load()
+
+var example = `Register A: 729
+Register B: 0
+Register C: 0
+
+Program: 0,1,5,4,3,0`
+var example2 = `Register A: 0
 Register B: 0
 Register C: 0
 
@@ -14,21 +23,22 @@ func Test_part1(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
-		want  int
+		want  string
 	}{
 		{
 			name:  "example",
 			input: example,
-			want:  0,
+			want:  "4,6,3,5,6,3,5,2,1,0",
 		},
 		// {
 		// 	name:  "actual",
 		// 	input: input,
-		// 	want:  0,
+		// 	want:  "",
 		// },
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -44,7 +54,7 @@ func Test_part2(t *testing.T) {
 	}{
 		{
 			name:  "example",
-			input: example,
+			input: example2,
 			want:  117440,
 		},
 		// {
@@ -55,6 +65,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
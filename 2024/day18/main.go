@@ -1,43 +1,17 @@
-package main
+package day18
 
 import (
-	_ "embed"
-	"flag"
 	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/zMoooooritz/advent-of-code/cast"
 	"github.com/zMoooooritz/advent-of-code/ds/spcl"
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2024, 18, solution.Funcs(part1, part2))
 }
 
 var grid [][]byte
@@ -91,13 +65,25 @@ func dijkstra(start, end spcl.Coordinate) int {
 	return -1
 }
 
+// setSize sizes the memory space to the coordinates, the example uses a
+// 7x7 space with 12 fallen bytes instead of 71x71 with 1024
+func setSize(coords []spcl.Coordinate) int {
+	size := 0
+	for _, coord := range coords {
+		size = max(size, coord.X+1, coord.Y+1)
+	}
+	width = size
+	height = size
+	if size <= 7 {
+		return 12
+	}
+	return 1024
+}
+
 func part1(input string) int {
 	coords := parseInput(input)
 
-	width = 71
-	height = 71
-
-	drawCount := 1024
+	drawCount := setSize(coords)
 
 	grid = make([][]byte, height)
 	for i := range height {
@@ -121,44 +107,40 @@ func part1(input string) int {
 
 var coords []spcl.Coordinate
 
-func binSearch(start, end int) int {
-	mid := (start + end) / 2
-
+// blocks reports whether the first n bytes cut off the exit
+func blocks(n int) bool {
 	grid = make([][]byte, height)
 	for i := range height {
 		grid[i] = slices.Repeat([]byte{'.'}, width)
 	}
+	for _, coord := range coords[:n] {
+		grid[coord.Y][coord.X] = '#'
+	}
 
-	for index, coord := range coords {
-		if index < mid {
-			grid[coord.Y][coord.X] = '#'
+	start := spcl.Coordinate{X: 0, Y: 0}
+	end := spcl.Coordinate{X: width - 1, Y: height - 1}
+	return dijkstra(start, end) == -1
+}
+
+// binSearch returns the index of the first byte cutting off the exit
+func binSearch(start, end int) int {
+	for start < end {
+		mid := (start + end) / 2
+		if blocks(mid + 1) {
+			end = mid
 		} else {
-			break
+			start = mid + 1
 		}
 	}
-	startCoord := spcl.Coordinate{X: 0, Y: 0}
-	endCoord := spcl.Coordinate{X: width - 1, Y: height - 1}
-	midResult := dijkstra(startCoord, endCoord)
-
-	if start == end-1 {
-		return mid
-	} else if midResult == -1 {
-		return binSearch(start, mid-1)
-	} else {
-		return binSearch(mid, end)
-	}
+	return start
 }
 
-func part2(input string) int {
+func part2(input string) string {
 	coords = parseInput(input)
+	setSize(coords)
 
-	width = 71
-	height = 71
-
-	breaking := binSearch(0, len(coords)-1)
-	fmt.Println(coords[breaking])
-
-	return 0
+	breaking := coords[binSearch(0, len(coords)-1)]
+	return fmt.Sprintf("%d,%d", breaking.X, breaking.Y)
 }
 
 func parseInput(input string) []spcl.Coordinate {
@@ -1,9 +1,13 @@
-package main
+package day18
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `5,4
 4,2
 4,5
@@ -49,6 +53,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -60,21 +65,22 @@ func Test_part2(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
-		want  int
+		want  string
 	}{
 		{
 			name:  "example",
 			input: example,
-			want:  0,
+			want:  "6,1",
 		},
 		// {
 		// 	name:  "actual",
 		// 	input: input,
-		// 	want:  0,
+		// 	want:  "",
 		// },
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
@@ -1,14 +1,23 @@
-package main
+package day22
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `1
 10
 100
 2024`
 
+var example2 = `1
+2
+3
+2024`
+
 func Test_part1(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -28,6 +37,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -43,7 +53,7 @@ func Test_part2(t *testing.T) {
 	}{
 		{
 			name:  "example",
-			input: example,
+			input: example2,
 			want:  23,
 		},
 		// {
@@ -54,6 +64,7 @@ func Test_part2(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
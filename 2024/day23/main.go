@@ -1,42 +1,15 @@
-package main
+package day23
 
 import (
-	_ "embed"
-	"flag"
-	"fmt"
 	"slices"
 	"sort"
 	"strings"
 
-	"github.com/zMoooooritz/advent-of-code/util"
+	"github.com/zMoooooritz/advent-of-code/solution"
 )
 
-//go:embed input.txt
-var input string
-
 func init() {
-	// do this in init (not main) so test file has same input
-	input = strings.TrimRight(input, "\n")
-	if len(input) == 0 {
-		panic("empty input.txt file")
-	}
-}
-
-func main() {
-	var part int
-	flag.IntVar(&part, "part", 1, "part 1 or 2")
-	flag.Parse()
-	fmt.Println("Running part", part)
-
-	if part == 1 {
-		ans := part1(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	} else {
-		ans := part2(input)
-		util.CopyToClipboard(fmt.Sprintf("%v", ans))
-		fmt.Println("Output:", ans)
-	}
+	solution.Register(2024, 23, solution.Funcs(part1, part2))
 }
 
 type Link struct {
@@ -129,7 +102,7 @@ func remove(slice []string, elem string) []string {
 	return result
 }
 
-func part2(input string) int {
+func part2(input string) string {
 	parseInput(input)
 
 	r := []string{}
@@ -143,17 +116,14 @@ func part2(input string) int {
 	bronKerbosch(r, p, x, &cliques)
 
 	maxClique := []string{}
-	maxSize := 0
 	for _, clique := range cliques {
-		if len(clique) > maxSize {
-			maxSize = len(clique)
+		if len(clique) > len(maxClique) {
 			maxClique = clique
 		}
 	}
 
 	sort.Sort(sort.StringSlice(maxClique))
-	fmt.Println(strings.Join(maxClique, ","))
-	return maxSize
+	return strings.Join(maxClique, ",")
 }
 
 func parseInput(input string) {
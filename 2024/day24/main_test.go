@@ -1,9 +1,13 @@
-package main
+package day24
 
 import (
 	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
 )
 
+var input = inputs.Load()
+
 var example = `x00: 1
 x01: 1
 x02: 1
@@ -15,6 +19,20 @@ x00 AND y00 -> z00
 x01 XOR y01 -> z01
 x02 OR y02 -> z02`
 
+// two bit adder with the outputs of z01 and r01 swapped
+var swappedAdder = `x00: 1
+x01: 0
+y00: 1
+y01: 1
+
+x00 XOR y00 -> z00
+x00 AND y00 -> c01
+x01 XOR y01 -> m01
+x01 AND y01 -> n01
+m01 XOR c01 -> r01
+m01 AND c01 -> z01
+n01 OR r01 -> z02`
+
 func Test_part1(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -34,6 +52,7 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part1(tt.input); got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
@@ -45,21 +64,22 @@ func Test_part2(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
-		want  int
+		want  string
 	}{
 		{
-			name:  "example",
-			input: example,
-			want:  0,
+			name:  "swapped adder",
+			input: swappedAdder,
+			want:  "r01,z01",
 		},
 		// {
 		// 	name:  "actual",
 		// 	input: input,
-		// 	want:  0,
+		// 	want:  "",
 		// },
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			inputs.SkipMissing(t, tt.input)
 			if got := part2(tt.input); got != tt.want {
 				t.Errorf("part2() = %v, want %v", got, tt.want)
 			}
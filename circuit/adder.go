@@ -0,0 +1,106 @@
+package circuit
+
+import (
+	"fmt"
+	"slices"
+)
+
+func isInput(wire string) bool {
+	return wire[0] == 'x' || wire[0] == 'y'
+}
+
+func isFirstBit(wire string) bool {
+	return wire == "x00" || wire == "y00"
+}
+
+// CheckAdder verifies that the circuit is a ripple-carry adder of the x and
+// y inputs into the z outputs. Every bit i is expected to be built as
+//
+//	x_i XOR y_i -> m_i        x_i AND y_i -> n_i
+//	m_i XOR c_i -> z_i        m_i AND c_i -> r_i
+//	r_i OR n_i  -> c_i+1
+//
+// with bit 0 being a half adder and the last carry being the top z wire. The
+// output wires of all gates breaking this structure are returned sorted.
+func (c *Circuit) CheckAdder() []string {
+	bits := 0
+	for _, g := range c.Gates {
+		for _, in := range []string{g.In1, g.In2} {
+			if in[0] == 'x' {
+				var bit int
+				fmt.Sscanf(in[1:], "%d", &bit)
+				bits = max(bits, bit+1)
+			}
+		}
+	}
+	lastZ := fmt.Sprintf("z%02d", bits)
+
+	consumedBy := map[string][]Operation{}
+	for _, g := range c.Gates {
+		consumedBy[g.In1] = append(consumedBy[g.In1], g.Op)
+		consumedBy[g.In2] = append(consumedBy[g.In2], g.Op)
+	}
+	feeds := func(wire string, op Operation) bool {
+		return slices.Contains(consumedBy[wire], op)
+	}
+
+	wrong := map[string]bool{}
+	for _, g := range c.Gates {
+		fromInputs := isInput(g.In1) && isInput(g.In2)
+		firstBit := isFirstBit(g.In1) && isFirstBit(g.In2)
+		isZ := g.Out[0] == 'z'
+
+		switch g.Op {
+		case XOR:
+			switch {
+			case firstBit:
+				// half adder sum
+				if g.Out != "z00" {
+					wrong[g.Out] = true
+				}
+			case fromInputs:
+				// m_i has to be added to the carry
+				if isZ || !feeds(g.Out, XOR) || !feeds(g.Out, AND) {
+					wrong[g.Out] = true
+				}
+			default:
+				// z_i = m_i XOR c_i
+				if !isZ || g.Out == lastZ {
+					wrong[g.Out] = true
+				}
+			}
+		case AND:
+			switch {
+			case firstBit && bits == 1:
+				if g.Out != lastZ {
+					wrong[g.Out] = true
+				}
+			case firstBit:
+				// half adder carry
+				if isZ || !feeds(g.Out, XOR) || !feeds(g.Out, AND) {
+					wrong[g.Out] = true
+				}
+			default:
+				// n_i and r_i are combined into the next carry
+				if isZ || !feeds(g.Out, OR) {
+					wrong[g.Out] = true
+				}
+			}
+		case OR:
+			// c_i+1 is either the last z or added to the next bit
+			if g.Out == lastZ {
+				continue
+			}
+			if isZ || !feeds(g.Out, XOR) || !feeds(g.Out, AND) {
+				wrong[g.Out] = true
+			}
+		}
+	}
+
+	result := []string{}
+	for wire := range wrong {
+		result = append(result, wire)
+	}
+	slices.Sort(result)
+	return result
+}
@@ -0,0 +1,232 @@
+// Package circuit simulates boolean circuits of two input logic gates as in
+// AOC 2024 day 24.
+package circuit
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type Operation int
+
+const (
+	AND Operation = iota
+	OR
+	XOR
+)
+
+var opNames = map[Operation]string{
+	AND: "AND",
+	OR:  "OR",
+	XOR: "XOR",
+}
+
+func (o Operation) String() string {
+	return opNames[o]
+}
+
+func (o Operation) apply(a, b bool) bool {
+	switch o {
+	case AND:
+		return a && b
+	case OR:
+		return a || b
+	default:
+		return a != b
+	}
+}
+
+type Gate struct {
+	In1 string
+	In2 string
+	Out string
+	Op  Operation
+}
+
+func (g Gate) String() string {
+	return fmt.Sprintf("%s %s %s -> %s", g.In1, g.Op, g.In2, g.Out)
+}
+
+type Circuit struct {
+	Inputs map[string]bool
+	Gates  []Gate
+}
+
+// Parse reads the initial wire values, a blank line and one gate per line
+func Parse(input string) (*Circuit, error) {
+	c := &Circuit{Inputs: map[string]bool{}}
+
+	wireInput := true
+	for _, line := range strings.Split(input, "\n") {
+		if line == "" {
+			wireInput = false
+			continue
+		}
+		if wireInput {
+			splt := strings.Split(line, ": ")
+			if len(splt) != 2 {
+				return nil, fmt.Errorf("invalid wire %q", line)
+			}
+			c.Inputs[splt[0]] = splt[1] == "1"
+			continue
+		}
+
+		var gate Gate
+		var op string
+		if _, err := fmt.Sscanf(line, "%s %s %s -> %s", &gate.In1, &op, &gate.In2, &gate.Out); err != nil {
+			return nil, fmt.Errorf("invalid gate %q: %w", line, err)
+		}
+		switch op {
+		case "AND":
+			gate.Op = AND
+		case "OR":
+			gate.Op = OR
+		case "XOR":
+			gate.Op = XOR
+		default:
+			return nil, fmt.Errorf("invalid operation %q", op)
+		}
+		c.Gates = append(c.Gates, gate)
+	}
+	return c, nil
+}
+
+// Order sorts the gates topologically, so every gate comes after the gates
+// driving its inputs. It fails if the circuit contains a cycle or a wire
+// that is neither an input nor driven by a gate.
+func (c *Circuit) Order() ([]Gate, error) {
+	drivers := map[string]int{}
+	for index, g := range c.Gates {
+		if _, ok := drivers[g.Out]; ok {
+			return nil, fmt.Errorf("wire %s is driven by multiple gates", g.Out)
+		}
+		drivers[g.Out] = index
+	}
+
+	consumers := map[string][]int{}
+	missing := make([]int, len(c.Gates))
+	queue := []int{}
+	for index, g := range c.Gates {
+		for _, in := range []string{g.In1, g.In2} {
+			if _, ok := c.Inputs[in]; ok {
+				continue
+			}
+			if _, ok := drivers[in]; !ok {
+				return nil, fmt.Errorf("wire %s is not driven", in)
+			}
+			consumers[in] = append(consumers[in], index)
+			missing[index]++
+		}
+		if missing[index] == 0 {
+			queue = append(queue, index)
+		}
+	}
+
+	order := []Gate{}
+	for len(queue) > 0 {
+		index := queue[0]
+		queue = queue[1:]
+		order = append(order, c.Gates[index])
+		for _, consumer := range consumers[c.Gates[index].Out] {
+			missing[consumer]--
+			if missing[consumer] == 0 {
+				queue = append(queue, consumer)
+			}
+		}
+	}
+
+	if len(order) != len(c.Gates) {
+		cyclic := []string{}
+		for index, g := range c.Gates {
+			if missing[index] > 0 {
+				cyclic = append(cyclic, g.Out)
+			}
+		}
+		slices.Sort(cyclic)
+		return nil, fmt.Errorf("cycle through wires %s", strings.Join(cyclic, ","))
+	}
+	return order, nil
+}
+
+// Evaluate returns the value of every wire
+func (c *Circuit) Evaluate() (map[string]bool, error) {
+	order, err := c.Order()
+	if err != nil {
+		return nil, err
+	}
+
+	wires := map[string]bool{}
+	for k, v := range c.Inputs {
+		wires[k] = v
+	}
+	for _, g := range order {
+		wires[g.Out] = g.Op.apply(wires[g.In1], wires[g.In2])
+	}
+	return wires, nil
+}
+
+// Number reads the wires starting with prefix as binary number, the wire
+// with suffix 00 being the least significant bit
+func Number(wires map[string]bool, prefix byte) int {
+	wireNames := []string{}
+	for n := range wires {
+		if n[0] == prefix {
+			wireNames = append(wireNames, n)
+		}
+	}
+
+	slices.Sort(wireNames)
+	slices.Reverse(wireNames)
+	result := 0
+	for _, w := range wireNames {
+		result <<= 1
+		if wires[w] {
+			result |= 1
+		}
+	}
+	return result
+}
+
+// Swap exchanges the output wires of the two gates driving a and b
+func (c *Circuit) Swap(a, b string) {
+	for index, g := range c.Gates {
+		switch g.Out {
+		case a:
+			c.Gates[index].Out = b
+		case b:
+			c.Gates[index].Out = a
+		}
+	}
+}
+
+// DOT renders the circuit in the Graphviz DOT language, wires are nodes and
+// every gate is a node labelled with its operation
+func (c *Circuit) DOT() string {
+	builder := strings.Builder{}
+	builder.WriteString("digraph circuit {\n")
+
+	inputs := []string{}
+	for in := range c.Inputs {
+		inputs = append(inputs, in)
+	}
+	slices.Sort(inputs)
+	for _, in := range inputs {
+		fmt.Fprintf(&builder, "\t%q [shape=box];\n", in)
+	}
+
+	gates := slices.Clone(c.Gates)
+	slices.SortFunc(gates, func(a, b Gate) int {
+		return strings.Compare(a.Out, b.Out)
+	})
+	for _, g := range gates {
+		node := "gate_" + g.Out
+		fmt.Fprintf(&builder, "\t%q [label=%q, shape=ellipse];\n", node, g.Op.String())
+		fmt.Fprintf(&builder, "\t%q -> %q;\n", g.In1, node)
+		fmt.Fprintf(&builder, "\t%q -> %q;\n", g.In2, node)
+		fmt.Fprintf(&builder, "\t%q -> %q;\n", node, g.Out)
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
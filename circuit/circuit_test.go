@@ -0,0 +1,182 @@
+package circuit_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/circuit"
+)
+
+var example = `x00: 1
+x01: 0
+x02: 1
+x03: 1
+x04: 0
+y00: 1
+y01: 1
+y02: 1
+y03: 1
+y04: 1
+
+ntg XOR fgs -> mjb
+y02 OR x01 -> tnw
+kwq OR kpj -> z05
+x00 OR x03 -> fst
+tgd XOR rvg -> z01
+vdt OR tnw -> bfw
+bfw AND frj -> z10
+ffh OR nrd -> bqk
+y00 AND y03 -> djm
+y03 OR y00 -> psh
+bqk OR frj -> z08
+tnw OR fst -> frj
+gnj AND tgd -> z11
+bfw XOR mjb -> z00
+x03 OR x00 -> vdt
+gnj AND wpb -> z02
+x04 AND y00 -> kjc
+djm OR pbm -> qhw
+nrd AND vdt -> hwm
+kjc AND fst -> rvg
+y04 OR y02 -> fgs
+y01 AND x02 -> pbm
+ntg OR kjc -> kwq
+psh XOR fgs -> tgd
+qhw XOR tgd -> z09
+pbm OR djm -> kpj
+x03 XOR y03 -> ffh
+x00 XOR y04 -> ntg
+bfw OR bqk -> z06
+nrd XOR fgs -> wpb
+frj XOR qhw -> z04
+bqk OR frj -> z07
+y03 OR x01 -> nrd
+hwm AND bqk -> z03
+tgd XOR rvg -> z12
+tnw OR pbm -> gnj`
+
+// adder builds a ripple-carry adder of the given width adding x and y
+func adder(bits, x, y int) string {
+	lines := []string{}
+	for i := range bits {
+		lines = append(lines, fmt.Sprintf("x%02d: %d", i, x>>i&1))
+	}
+	for i := range bits {
+		lines = append(lines, fmt.Sprintf("y%02d: %d", i, y>>i&1))
+	}
+	lines = append(lines, "")
+	firstCarry := "c01"
+	if bits == 1 {
+		firstCarry = "z01"
+	}
+	lines = append(lines, "x00 XOR y00 -> z00", "x00 AND y00 -> "+firstCarry)
+	for i := 1; i < bits; i++ {
+		carry := fmt.Sprintf("c%02d", i+1)
+		if i == bits-1 {
+			carry = fmt.Sprintf("z%02d", bits)
+		}
+		lines = append(lines,
+			fmt.Sprintf("x%02d XOR y%02d -> m%02d", i, i, i),
+			fmt.Sprintf("x%02d AND y%02d -> n%02d", i, i, i),
+			fmt.Sprintf("m%02d XOR c%02d -> z%02d", i, i, i),
+			fmt.Sprintf("m%02d AND c%02d -> r%02d", i, i, i),
+			fmt.Sprintf("r%02d OR n%02d -> %s", i, i, carry),
+		)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func parse(t *testing.T, input string) *circuit.Circuit {
+	t.Helper()
+	c, err := circuit.Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return c
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{"example", example, 2024},
+		{"adder", adder(8, 200, 77), 277},
+		{"adder_overflow", adder(4, 15, 15), 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wires, err := parse(t, tt.input).Evaluate()
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if got := circuit.Number(wires, 'z'); got != tt.want {
+				t.Errorf("Number() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"cycle", "x00: 1\n\nx00 AND b -> a\na OR x00 -> b\na XOR x00 -> z00", "cycle through wires a,b,z00"},
+		{"undriven", "x00: 1\n\nx00 AND b -> z00", "wire b is not driven"},
+		{"multiple", "x00: 1\n\nx00 AND x00 -> z00\nx00 OR x00 -> z00", "wire z00 is driven by multiple gates"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parse(t, tt.input).Evaluate()
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("Evaluate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckAdder(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		swaps [][2]string
+		want  []string
+	}{
+		{"correct", adder(12, 0, 0), nil, []string{}},
+		{"half_adder", adder(1, 0, 0), nil, []string{}},
+		{"sum_with_carry", adder(12, 0, 0), [][2]string{{"z05", "c06"}}, []string{"c06", "z05"}},
+		{"inputs", adder(12, 0, 0), [][2]string{{"m03", "n03"}}, []string{"m03", "n03"}},
+		{"two_swaps", adder(12, 0, 0), [][2]string{{"z07", "r07"}, {"m09", "z10"}}, []string{"m09", "r07", "z07", "z10"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := parse(t, tt.input)
+			for _, swap := range tt.swaps {
+				c.Swap(swap[0], swap[1])
+			}
+			if got := c.CheckAdder(); !slices.Equal(got, tt.want) {
+				t.Errorf("CheckAdder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDOT(t *testing.T) {
+	want := `digraph circuit {
+	"x00" [shape=box];
+	"y00" [shape=box];
+	"gate_z00" [label="XOR", shape=ellipse];
+	"x00" -> "gate_z00";
+	"y00" -> "gate_z00";
+	"gate_z00" -> "z00";
+}
+`
+	if got := parse(t, "x00: 1\ny00: 0\n\nx00 XOR y00 -> z00").DOT(); got != want {
+		t.Errorf("DOT() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,55 @@
+// Package inputs loads the puzzle inputs of the days. Inputs are not checked
+// in, so a fresh clone has none and every loader copes with missing files.
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Name is the input file in the directory of a day
+const Name = "input.txt"
+
+// Missing is returned by Load in place of an input that does not exist or
+// is empty, test cases running on it are skipped by SkipMissing
+const Missing = "\x00missing input"
+
+// ErrMissing is wrapped by Read if the input does not exist or is empty
+var ErrMissing = errors.New("puzzle input is missing or empty")
+
+// Read returns the content of the input file without trailing newlines
+func Read(filename string) (string, error) {
+	content, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%w: %s does not exist", ErrMissing, filename)
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading input: %w", err)
+	}
+	input := strings.TrimRight(string(content), "\n")
+	if strings.TrimSpace(input) == "" {
+		return "", fmt.Errorf("%w: %s is empty", ErrMissing, filename)
+	}
+	return input, nil
+}
+
+// Load returns the input.txt of the working directory, which is the
+// directory of the package under go test, or Missing
+func Load() string {
+	input, err := Read(Name)
+	if err != nil {
+		return Missing
+	}
+	return input
+}
+
+// SkipMissing skips the test if it runs on a missing input
+func SkipMissing(t testing.TB, input string) {
+	t.Helper()
+	if input == Missing {
+		t.Skipf("%s is missing or empty, fetch it with make input", Name)
+	}
+}
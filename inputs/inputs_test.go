@@ -0,0 +1,69 @@
+package inputs_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
+)
+
+func TestRead(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"input.txt":  "1 2\n3 4\n\n",
+		"empty.txt":  "",
+		"blank.txt":  "\n\n",
+		"spaced.txt": "  x\n",
+	} {
+		os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    string
+		missing bool
+	}{
+		{"trims trailing newlines", "input.txt", "1 2\n3 4", false},
+		{"keeps leading spaces", "spaced.txt", "  x", false},
+		{"empty", "empty.txt", "", true},
+		{"only newlines", "blank.txt", "", true},
+		{"does not exist", "other.txt", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inputs.Read(filepath.Join(dir, tt.file))
+			if errors.Is(err, inputs.ErrMissing) != tt.missing {
+				t.Fatalf("Read() error = %v, missing %v", err, tt.missing)
+			}
+			if got != tt.want {
+				t.Errorf("Read() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	// the package directory has no input.txt
+	if got := inputs.Load(); got != inputs.Missing {
+		t.Errorf("Load() = %q, want Missing", got)
+	}
+}
+
+func TestSkipMissing(t *testing.T) {
+	skipped := t.Run("missing", func(t *testing.T) {
+		inputs.SkipMissing(t, inputs.Missing)
+		t.Error("SkipMissing() did not skip a missing input")
+	})
+	if !skipped {
+		t.Error("missing input failed")
+	}
+	t.Run("example", func(t *testing.T) {
+		inputs.SkipMissing(t, "1 2")
+		if t.Skipped() {
+			t.Error("SkipMissing() skipped an example")
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package intcode
+
+import (
+	"slices"
+	"strings"
+)
+
+// ASCII wraps a CPU that exchanges text, inputs are sent as ASCII codes and
+// outputs are collected into lines. Output values above 127 can't be text
+// and are collected as numeric results instead.
+type ASCII struct {
+	CPU *CPU
+
+	line    strings.Builder
+	read    int
+	results []int
+}
+
+func NewASCII(cpu *CPU) *ASCII {
+	return &ASCII{CPU: cpu}
+}
+
+// SendLine queues the command followed by a newline
+func (a *ASCII) SendLine(command string) {
+	for _, char := range command {
+		a.CPU.SendInput(int(char))
+	}
+	a.CPU.SendInput('\n')
+}
+
+// Run runs the CPU until it halts or waits for input and returns the lines
+// that were completed in the meantime
+func (a *ASCII) Run() []string {
+	a.CPU.Run()
+
+	lines := []string{}
+	outputs := a.CPU.Outputs()
+	for _, value := range outputs[a.read:] {
+		switch {
+		case value > 127:
+			a.results = append(a.results, value)
+		case value == '\n':
+			lines = append(lines, a.line.String())
+			a.line.Reset()
+		default:
+			a.line.WriteRune(rune(value))
+		}
+	}
+	a.read = len(outputs)
+	return lines
+}
+
+// Pending returns the text of the line that was not terminated yet, e.g. a
+// prompt without newline
+func (a *ASCII) Pending() string {
+	return a.line.String()
+}
+
+// Result returns the last numeric output, ok is false if there was none
+func (a *ASCII) Result() (value int, ok bool) {
+	if len(a.results) == 0 {
+		return 0, false
+	}
+	return a.results[len(a.results)-1], true
+}
+
+// Snapshot copies the state of the wrapped CPU, the numeric results and the
+// pending line
+func (a *ASCII) Snapshot() Snapshot {
+	s := a.CPU.Snapshot()
+	s.results = slices.Clone(a.results)
+	s.pending = a.line.String()
+	return s
+}
+
+// Restore resets the wrapped CPU, the numeric results and the pending line,
+// output that was already returned by Run is not returned again
+func (a *ASCII) Restore(s Snapshot) {
+	a.CPU.Restore(s)
+	a.read = len(a.CPU.Outputs())
+	a.line.Reset()
+	a.line.WriteString(s.pending)
+	a.results = slices.Clone(s.results)
+}
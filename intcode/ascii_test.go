@@ -0,0 +1,119 @@
+package intcode_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/intcode"
+)
+
+// echoes one line and outputs 1000 afterwards
+const echo = "3,100,4,100,1008,100,10,101,1006,101,0,104,1000,99"
+
+// prints ? and echoes lines forever
+const prompt = "104,63,104,10,3,100,4,100,1008,100,10,101,1006,101,4,1105,1,0"
+
+// prints "? " without newline before echoing every character
+const inlinePrompt = "104,63,104,32,3,100,4,100,1105,1,0"
+
+func TestASCII(t *testing.T) {
+	ascii := intcode.NewASCII(intcode.NewCPU(intcode.ParseProgram(echo)))
+
+	if got := ascii.Run(); len(got) != 0 || !ascii.CPU.WaitingForInput() {
+		t.Fatalf("Run() = %q, want no lines while waiting for input", got)
+	}
+
+	ascii.SendLine("hello")
+	if got := ascii.Run(); !slices.Equal(got, []string{"hello"}) {
+		t.Errorf("Run() = %q, want [hello]", got)
+	}
+	if got, ok := ascii.Result(); !ok || got != 1000 {
+		t.Errorf("Result() = %v, %v, want 1000, true", got, ok)
+	}
+	if !ascii.CPU.HasHalted() {
+		t.Errorf("HasHalted() = false, want true")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	ascii := intcode.NewASCII(intcode.NewCPU(intcode.ParseProgram(prompt)))
+	ascii.Run()
+	snapshot := ascii.Snapshot()
+
+	ascii.SendLine("first")
+	if got := ascii.Run(); !slices.Equal(got, []string{"first", "?"}) {
+		t.Errorf("Run() = %q, want [first ?]", got)
+	}
+
+	ascii.Restore(snapshot)
+	ascii.SendLine("second")
+	if got := ascii.Run(); !slices.Equal(got, []string{"second", "?"}) {
+		t.Errorf("Run() after Restore = %q, want [second ?]", got)
+	}
+}
+
+func TestSnapshotResults(t *testing.T) {
+	ascii := intcode.NewASCII(intcode.NewCPU(intcode.ParseProgram(echo)))
+	ascii.Run()
+	snapshot := ascii.Snapshot()
+
+	ascii.SendLine("hello")
+	ascii.Run()
+	if _, ok := ascii.Result(); !ok {
+		t.Fatalf("Result() ok = false, want true")
+	}
+
+	ascii.Restore(snapshot)
+	if got, ok := ascii.Result(); ok {
+		t.Errorf("Result() after Restore = %v, want none", got)
+	}
+}
+
+func TestSnapshotPending(t *testing.T) {
+	ascii := intcode.NewASCII(intcode.NewCPU(intcode.ParseProgram(inlinePrompt)))
+	ascii.Run()
+	snapshot := ascii.Snapshot()
+
+	ascii.SendLine("a")
+	if got := ascii.Run(); !slices.Equal(got, []string{"? a? "}) {
+		t.Errorf("Run() = %q, want [? a? ]", got)
+	}
+
+	ascii.Restore(snapshot)
+	if got := ascii.Pending(); got != "? " {
+		t.Errorf("Pending() after Restore = %q, want %q", got, "? ")
+	}
+	ascii.SendLine("b")
+	if got := ascii.Run(); !slices.Equal(got, []string{"? b? "}) {
+		t.Errorf("Run() after Restore = %q, want [? b? ]", got)
+	}
+}
+
+func TestInteractive(t *testing.T) {
+	ascii := intcode.NewASCII(intcode.NewCPU(intcode.ParseProgram(prompt)))
+	commands := "a\n!save s\nb\n!load s\n!list\n!load x\nc\n!quit\n"
+
+	out := strings.Builder{}
+	if err := intcode.Interactive(ascii, strings.NewReader(commands), &out); err != nil {
+		t.Fatalf("Interactive() error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		"?",
+		"> a",
+		"?",
+		"> Saved s",
+		"> b",
+		"?",
+		"> Loaded s",
+		"> s",
+		"> Unknown snapshot x",
+		"> c",
+		"?",
+		"> ",
+	}, "\n")
+	if got := out.String(); got != want {
+		t.Errorf("Interactive() wrote %q, want %q", got, want)
+	}
+}
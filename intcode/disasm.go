@@ -0,0 +1,112 @@
+package intcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+var opNames = map[OpCode]string{
+	ADD:  "ADD",
+	MUL:  "MUL",
+	IN:   "IN",
+	OUT:  "OUT",
+	JIT:  "JIT",
+	JIF:  "JIF",
+	LT:   "LT",
+	EQ:   "EQ",
+	RBO:  "RBO",
+	HALT: "HALT",
+}
+
+// opcodes whose last parameter is the address that gets written
+var writesLast = map[OpCode]bool{
+	ADD: true,
+	MUL: true,
+	IN:  true,
+	LT:  true,
+	EQ:  true,
+}
+
+func (o OpCode) String() string {
+	if name, ok := opNames[o]; ok {
+		return name
+	}
+	return fmt.Sprintf("OP%d", int(o))
+}
+
+// String formats the parameter as #value (immediate), [address] (position)
+// or [rb+offset] (relative)
+func (p Parameter) String() string {
+	switch p.mode {
+	case IMMEDIATE:
+		return fmt.Sprintf("#%d", p.value)
+	case RELATIVE:
+		return fmt.Sprintf("[rb%+d]", p.value)
+	default:
+		return fmt.Sprintf("[%d]", p.value)
+	}
+}
+
+// String formats the instruction like ADD [12], #5 -> [3]
+func (i Instruction) String() string {
+	params := i.params
+	target := ""
+	if writesLast[i.opcode] && len(params) > 0 {
+		target = " -> " + params[len(params)-1].String()
+		params = params[:len(params)-1]
+	}
+
+	args := []string{}
+	for _, p := range params {
+		args = append(args, p.String())
+	}
+
+	str := i.opcode.String()
+	if len(args) > 0 {
+		str += " " + strings.Join(args, ", ")
+	}
+	return str + target
+}
+
+// decode reads the instruction at address, ok is false if the value there
+// is no valid instruction (e.g. data stored after the code)
+func decode(program []int, address int) (inst Instruction, ok bool) {
+	value := program[address]
+	opcode := OpCode(value % 100)
+	argCount, known := opArgCount[opcode]
+	if !known || value < 0 || address+argCount >= len(program) {
+		return Instruction{}, false
+	}
+
+	inst.opcode = opcode
+	parameterModes := value / 100
+	for index := range argCount {
+		mode := ParameterMode(parameterModes % 10)
+		if mode > RELATIVE || (mode == IMMEDIATE && writesLast[opcode] && index == argCount-1) {
+			return Instruction{}, false
+		}
+		inst.params = append(inst.params, Parameter{program[address+index+1], mode})
+		parameterModes /= 10
+	}
+	if parameterModes != 0 {
+		return Instruction{}, false
+	}
+	return inst, true
+}
+
+// Disassemble turns the program into one line per instruction prefixed with
+// its address, values that do not decode to an instruction are shown as DATA
+func Disassemble(program []int) []string {
+	lines := []string{}
+	for address := 0; address < len(program); {
+		inst, ok := decode(program, address)
+		if !ok {
+			lines = append(lines, fmt.Sprintf("%04d: DATA %d", address, program[address]))
+			address += 1
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%04d: %s", address, inst))
+		address += len(inst.params) + 1
+	}
+	return lines
+}
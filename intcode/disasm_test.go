@@ -0,0 +1,50 @@
+package intcode_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/intcode"
+)
+
+func TestDisassemble(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		want    []string
+	}{
+		{
+			name:    "modes",
+			program: "1001,12,5,3,99",
+			want:    []string{"0000: ADD [12], #5 -> [3]", "0004: HALT"},
+		},
+		{
+			name:    "io_and_relative",
+			program: "109,-3,203,2,204,-1,99",
+			want:    []string{"0000: RBO #-3", "0002: IN -> [rb+2]", "0004: OUT [rb-1]", "0006: HALT"},
+		},
+		{
+			name:    "jumps_and_data",
+			program: "1105,1,4,99,1008,9,8,9,99,-1",
+			want: []string{
+				"0000: JIT #1, #4",
+				"0003: HALT",
+				"0004: EQ [9], #8 -> [9]",
+				"0008: HALT",
+				"0009: DATA -1",
+			},
+		},
+		{
+			name:    "truncated",
+			program: "1,0,0",
+			want:    []string{"0000: DATA 1", "0001: DATA 0", "0002: DATA 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := intcode.Disassemble(intcode.ParseProgram(tt.program)); !slices.Equal(got, tt.want) {
+				t.Errorf("Disassemble() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Package intcode implements the intcode virtual machine used throughout AOC 2019.
+package intcode
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/zMoooooritz/advent-of-code/cast"
+)
+
+type OpCode int
+
+const (
+	NULLCODE OpCode = iota
+	ADD
+	MUL
+	IN
+	OUT
+	JIT
+	JIF
+	LT
+	EQ
+	RBO
+	HALT OpCode = 99
+)
+
+var opArgCount = map[OpCode]int{
+	ADD:  3,
+	MUL:  3,
+	IN:   1,
+	OUT:  1,
+	JIT:  2,
+	JIF:  2,
+	LT:   3,
+	EQ:   3,
+	RBO:  1,
+	HALT: 0,
+}
+
+type ParameterMode int
+
+const (
+	POSITION ParameterMode = iota
+	IMMEDIATE
+	RELATIVE
+)
+
+type Parameter struct {
+	value int
+	mode  ParameterMode
+}
+
+type Instruction struct {
+	opcode OpCode
+	params []Parameter
+}
+
+// ParseProgram turns the comma separated puzzle input into a program
+func ParseProgram(input string) []int {
+	return cast.ToIntSliceSep(input, ",")
+}
+
+type CPU struct {
+	memory             []int
+	instructionPointer int
+	relativeBase       int
+
+	inputs       []int
+	inputPointer int
+
+	outputs []int
+	halted  bool
+	waiting bool
+	stopped atomic.Bool
+
+	// set when running concurrently, see Start and Network
+	inputFn  func() int
+	outputFn func(int)
+
+	pauseOnIO bool
+
+	tracer *Trace
+}
+
+// NewCPU creates a CPU running a copy of the given program, the optional
+// inputs are consumed in order by the IN instruction
+func NewCPU(program []int, inputs ...int) *CPU {
+	memory := make([]int, len(program))
+	copy(memory, program)
+	return &CPU{
+		memory: memory,
+		inputs: append([]int{}, inputs...),
+	}
+}
+
+// Run executes instructions until the program halts or needs an input that
+// was not sent yet, if pauseOnIO is set it additionally returns after every
+// OUT instruction
+func (c *CPU) Run() {
+	c.waiting = false
+	for !c.halted && !c.stopped.Load() {
+		inst := c.parseCurrentInstruction()
+		if inst.opcode == HALT {
+			c.halted = true
+			break
+		}
+		if inst.opcode == IN && !c.hasInput() {
+			c.waiting = true
+			break
+		}
+
+		c.trace(Event{Kind: EXEC, Address: c.instructionPointer, Instruction: inst})
+		c.instructionPointer += len(inst.params) + 1 // can be altered in the instructions
+		c.execute(inst)
+
+		if c.pauseOnIO && inst.opcode == OUT {
+			break
+		}
+	}
+}
+
+// Stop makes a running CPU return after its current instruction
+func (c *CPU) Stop() {
+	c.stopped.Store(true)
+}
+
+func (c *CPU) DoPauseOnIO(doPause bool) {
+	c.pauseOnIO = doPause
+}
+
+func (c *CPU) HasHalted() bool {
+	return c.halted
+}
+
+// WaitingForInput reports whether the last Run stopped at an IN instruction
+// because no input was available
+func (c *CPU) WaitingForInput() bool {
+	return c.waiting
+}
+
+func (c *CPU) SendInput(input int) {
+	c.inputs = append(c.inputs, input)
+}
+
+// RecvOutput returns the most recent output value
+func (c *CPU) RecvOutput() int {
+	if len(c.outputs) == 0 {
+		return 0
+	}
+	return c.outputs[len(c.outputs)-1]
+}
+
+// Outputs returns all values written by OUT so far
+func (c *CPU) Outputs() []int {
+	return c.outputs
+}
+
+// Read returns the value at the given address, untouched memory reads as 0
+func (c *CPU) Read(address int) int {
+	if address < 0 {
+		panic(fmt.Sprintf("negative memory address %d", address))
+	}
+	if address >= len(c.memory) {
+		return 0
+	}
+	return c.memory[address]
+}
+
+// Write stores value at the given address, growing memory when necessary
+func (c *CPU) Write(address, value int) {
+	if address < 0 {
+		panic(fmt.Sprintf("negative memory address %d", address))
+	}
+	if address >= len(c.memory) {
+		c.memory = append(c.memory, make([]int, address-len(c.memory)+1)...)
+	}
+	c.memory[address] = value
+}
+
+func (c *CPU) execute(inst Instruction) {
+	args := inst.params
+	switch inst.opcode {
+	case ADD:
+		c.store(args[2], c.evalParameter(args[0])+c.evalParameter(args[1]))
+	case MUL:
+		c.store(args[2], c.evalParameter(args[0])*c.evalParameter(args[1]))
+	case IN:
+		c.store(args[0], c.recvInput())
+	case OUT:
+		c.sendOutput(c.evalParameter(args[0]))
+	case JIT:
+		if c.evalParameter(args[0]) != 0 {
+			c.instructionPointer = c.evalParameter(args[1])
+		}
+	case JIF:
+		if c.evalParameter(args[0]) == 0 {
+			c.instructionPointer = c.evalParameter(args[1])
+		}
+	case LT:
+		c.store(args[2], boolToInt(c.evalParameter(args[0]) < c.evalParameter(args[1])))
+	case EQ:
+		c.store(args[2], boolToInt(c.evalParameter(args[0]) == c.evalParameter(args[1])))
+	case RBO:
+		c.relativeBase += c.evalParameter(args[0])
+	default:
+		panic(fmt.Sprintf("invalid opcode %d", inst.opcode))
+	}
+}
+
+// store writes an instruction result to the address given by param
+func (c *CPU) store(param Parameter, value int) {
+	address := c.address(param)
+	c.trace(Event{Kind: WRITE, Address: address, Value: value})
+	c.Write(address, value)
+}
+
+func (c *CPU) hasInput() bool {
+	return c.inputPointer < len(c.inputs) || c.inputFn != nil
+}
+
+// recvInput drains the queued inputs before falling back to inputFn
+func (c *CPU) recvInput() int {
+	var value int
+	if c.inputPointer < len(c.inputs) {
+		value = c.inputs[c.inputPointer]
+		c.inputPointer += 1
+	} else if c.inputFn != nil {
+		value = c.inputFn()
+	} else {
+		panic("no input available")
+	}
+	c.trace(Event{Kind: INPUT, Value: value})
+	return value
+}
+
+func (c *CPU) sendOutput(value int) {
+	c.trace(Event{Kind: OUTPUT, Value: value})
+	if c.outputFn != nil {
+		c.outputFn(value)
+		return
+	}
+	c.outputs = append(c.outputs, value)
+}
+
+func (c *CPU) parseCurrentInstruction() Instruction {
+	inst := c.Read(c.instructionPointer)
+	opcode := OpCode(inst % 100)
+	argCount := operationArgumentCount(opcode)
+
+	instruction := Instruction{opcode: opcode}
+	parameterModes := inst / 100
+	for index := range argCount {
+		instruction.params = append(instruction.params, Parameter{c.Read(c.instructionPointer + index + 1), ParameterMode(parameterModes % 10)})
+		parameterModes /= 10
+	}
+	return instruction
+}
+
+func operationArgumentCount(opCode OpCode) int {
+	if count, ok := opArgCount[opCode]; ok {
+		return count
+	}
+	panic(fmt.Sprintf("invalid opcode %d", opCode))
+}
+
+func (c *CPU) address(param Parameter) int {
+	switch param.mode {
+	case POSITION:
+		return param.value
+	case RELATIVE:
+		return c.relativeBase + param.value
+	default:
+		panic(fmt.Sprintf("invalid parameter mode %d for write", param.mode))
+	}
+}
+
+func (c *CPU) evalParameter(param Parameter) int {
+	if param.mode == IMMEDIATE {
+		return param.value
+	}
+	return c.Read(c.address(param))
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
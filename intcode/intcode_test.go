@@ -0,0 +1,79 @@
+package intcode_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/intcode"
+)
+
+func TestRunMemory(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		address int
+		want    int
+	}{
+		{"add", "1,0,0,0,99", 0, 2},
+		{"mul", "2,3,0,3,99", 3, 6},
+		{"mul_after_halt", "2,4,4,5,99,0", 5, 9801},
+		{"advanced", "1,1,1,4,99,5,6,0,99", 0, 30},
+		{"immediate", "1002,4,3,4,33", 4, 99},
+		{"negative", "1101,100,-1,4,0", 4, 99},
+		{"grow_memory", "1101,2,3,1000,99", 1000, 5},
+		{"read_beyond_program", "1,1000,1001,0,99", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cpu := intcode.NewCPU(intcode.ParseProgram(tt.program))
+			cpu.Run()
+			if got := cpu.Read(tt.address); got != tt.want {
+				t.Errorf("Read(%d) = %v, want %v", tt.address, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunIO(t *testing.T) {
+	compare := "3,21,1008,21,8,20,1005,20,22,107,8,21,20,1006,20,31,1106,0,36,98,0,0,1002,21,125,20,4,20,1105,1,46,104,999,1105,1,46,1101,1000,1,20,4,20,1105,1,46,98,99"
+	quine := "109,1,204,-1,1001,100,1,100,1008,100,16,101,1006,101,0,99"
+	tests := []struct {
+		name    string
+		program string
+		inputs  []int
+		want    []int
+	}{
+		{"equal_position", "3,9,8,9,10,9,4,9,99,-1,8", []int{8}, []int{1}},
+		{"less_immediate", "3,3,1107,-1,8,3,4,3,99", []int{9}, []int{0}},
+		{"jump_position", "3,12,6,12,15,1,13,14,13,4,13,99,-1,0,1,9", []int{0}, []int{0}},
+		{"jump_immediate", "3,3,1105,-1,9,1101,0,0,12,4,12,99,1", []int{3}, []int{1}},
+		{"compare_below", compare, []int{7}, []int{999}},
+		{"compare_equal", compare, []int{8}, []int{1000}},
+		{"compare_above", compare, []int{9}, []int{1001}},
+		{"quine", quine, nil, intcode.ParseProgram(quine)},
+		{"large_multiply", "1102,34915192,34915192,7,4,7,99,0", nil, []int{1219070632396864}},
+		{"large_output", "104,1125899906842624,99", nil, []int{1125899906842624}},
+		{"relative_input", "109,5,203,2,204,2,99", []int{42}, []int{42}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cpu := intcode.NewCPU(intcode.ParseProgram(tt.program), tt.inputs...)
+			cpu.Run()
+			if got := cpu.Outputs(); !slices.Equal(got, tt.want) {
+				t.Errorf("Outputs() = %v, want %v", got, tt.want)
+			}
+			if !cpu.HasHalted() {
+				t.Errorf("HasHalted() = false, want true")
+			}
+		})
+	}
+}
+
+func TestNewCPUCopiesProgram(t *testing.T) {
+	program := intcode.ParseProgram("1,0,0,0,99")
+	cpu := intcode.NewCPU(program)
+	cpu.Run()
+	if program[0] != 1 {
+		t.Errorf("program[0] = %v after run, want 1", program[0])
+	}
+}
@@ -0,0 +1,197 @@
+package intcode
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Start runs the CPU in its own goroutine. Once the queued inputs are used up
+// IN reads from in, every OUT value is sent to out. out is closed when the
+// program halts, or stops because in was closed while it waits for input,
+// and the returned channel is closed afterwards.
+func (c *CPU) Start(in <-chan int, out chan<- int) <-chan struct{} {
+	c.inputFn = func() int {
+		value, ok := <-in
+		if !ok {
+			// e.g. the previous machine of a chain halted, no input will come
+			c.Stop()
+		}
+		return value
+	}
+	c.outputFn = func(value int) {
+		out <- value
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.Run()
+		close(out)
+		close(done)
+	}()
+	return done
+}
+
+// Chain starts the machines with the outputs of each one connected to the
+// inputs of the next. Values sent on the returned input reach the first
+// machine, the returned output carries the values of the last one.
+func Chain(cpus ...*CPU) (chan<- int, <-chan int) {
+	in, out, _ := chain(cpus)
+	return in, out
+}
+
+func chain(cpus []*CPU) (chan int, chan int, <-chan struct{}) {
+	first := make(chan int)
+	in := first
+	var firstDone <-chan struct{}
+	for index, cpu := range cpus {
+		out := make(chan int)
+		done := cpu.Start(in, out)
+		if index == 0 {
+			firstDone = done
+		}
+		in = out
+	}
+	return first, in, firstDone
+}
+
+// Ring starts the machines like Chain and feeds the outputs of the last
+// machine back into the first one, e.g. the amplifier feedback loop. The
+// returned output yields the last value that went around the ring once the
+// last machine halted.
+func Ring(cpus ...*CPU) (chan<- int, <-chan int) {
+	in, out, firstDone := chain(cpus)
+	result := make(chan int, 1)
+	go func() {
+		last := 0
+		for value := range out {
+			last = value
+			select {
+			case in <- value:
+			case <-firstDone:
+			}
+		}
+		result <- last
+	}()
+	return in, result
+}
+
+// Packet is a X, Y pair sent to the machine with the given address
+type Packet struct {
+	Address int
+	X       int
+	Y       int
+}
+
+// Network runs one machine per address. Every machine first receives its
+// own address, afterwards it reads X, Y of the packets sent to it or -1 if
+// its queue is empty. Outputs are grouped into packets and routed by address.
+type Network struct {
+	machines []*CPU
+	idle     []atomic.Int32
+
+	// unbounded, so a machine sending packets never blocks
+	mu     sync.Mutex
+	queues [][]Packet
+
+	stopOnce sync.Once
+}
+
+func NewNetwork(program []int, size int) *Network {
+	n := &Network{
+		machines: make([]*CPU, size),
+		queues:   make([][]Packet, size),
+		idle:     make([]atomic.Int32, size),
+	}
+	for address := range size {
+		n.machines[address] = NewCPU(program, address)
+	}
+	return n
+}
+
+// Send queues a packet for the machine with the packet address
+func (n *Network) Send(packet Packet) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.queues[packet.Address] = append(n.queues[packet.Address], packet)
+}
+
+// receive takes the next packet from the queue of the address
+func (n *Network) receive(address int) (Packet, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.queues[address]) == 0 {
+		return Packet{}, false
+	}
+	packet := n.queues[address][0]
+	n.queues[address] = n.queues[address][1:]
+	return packet, true
+}
+
+// Idle reports whether every queue is empty and every machine has asked for
+// input at least twice without receiving any
+func (n *Network) Idle() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for address, queue := range n.queues {
+		if len(queue) > 0 || n.idle[address].Load() < 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// Stop makes every machine return, Run returns once all of them did
+func (n *Network) Stop() {
+	n.stopOnce.Do(func() {
+		for _, cpu := range n.machines {
+			cpu.Stop()
+		}
+	})
+}
+
+// Run starts all machines and blocks until every machine halted or Stop
+// was called. Packets to addresses outside the network are passed to
+// external, which is called from the sending machine's goroutine.
+func (n *Network) Run(external func(Packet)) {
+	var wg sync.WaitGroup
+	for address, cpu := range n.machines {
+		var pending []int
+		cpu.inputFn = func() int {
+			if len(pending) > 0 {
+				value := pending[0]
+				pending = pending[1:]
+				return value
+			}
+			if packet, ok := n.receive(address); ok {
+				n.idle[address].Store(0)
+				pending = append(pending, packet.Y)
+				return packet.X
+			}
+			n.idle[address].Add(1)
+			return -1
+		}
+
+		var packet []int
+		cpu.outputFn = func(value int) {
+			n.idle[address].Store(0)
+			packet = append(packet, value)
+			if len(packet) < 3 {
+				return
+			}
+			p := Packet{packet[0], packet[1], packet[2]}
+			packet = nil
+			if p.Address >= 0 && p.Address < len(n.machines) {
+				n.Send(p)
+			} else {
+				external(p)
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cpu.Run()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,100 @@
+package intcode_test
+
+import (
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/intcode"
+)
+
+func amplifiers(program string, phases []int) []*intcode.CPU {
+	cpus := []*intcode.CPU{}
+	for _, phase := range phases {
+		cpus = append(cpus, intcode.NewCPU(intcode.ParseProgram(program), phase))
+	}
+	return cpus
+}
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		phases  []int
+		want    int
+	}{
+		{"example", "3,15,3,16,1002,16,10,16,1,16,15,15,4,15,99,0,0", []int{4, 3, 2, 1, 0}, 43210},
+		{"example2", "3,23,3,24,1002,24,10,24,1002,23,-1,23,101,5,23,23,1,24,23,23,4,23,99,0,0", []int{0, 1, 2, 3, 4}, 54321},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in, out := intcode.Chain(amplifiers(tt.program, tt.phases)...)
+			in <- 0
+			if got := <-out; got != tt.want {
+				t.Errorf("Chain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChainUpstreamHalts(t *testing.T) {
+	// outputs its input once, the second machine waits for a second value
+	once := intcode.NewCPU(intcode.ParseProgram("3,9,4,9,99"))
+	twice := intcode.NewCPU(intcode.ParseProgram("3,11,3,12,1,11,12,13,4,13,99,0,0,0"))
+
+	in, out := intcode.Chain(once, twice)
+	in <- 5
+	if value, ok := <-out; ok {
+		t.Errorf("Chain() output %v, want it closed", value)
+	}
+	if twice.HasHalted() {
+		t.Errorf("HasHalted() = true for the machine without input")
+	}
+}
+
+func TestRing(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		phases  []int
+		want    int
+	}{
+		{"example", "3,26,1001,26,-4,26,3,27,1002,27,2,27,1,27,26,27,4,27,1001,28,-1,28,1005,28,6,99,0,0,5", []int{9, 8, 7, 6, 5}, 139629729},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in, result := intcode.Ring(amplifiers(tt.program, tt.phases)...)
+			in <- 0
+			if got := <-result; got != tt.want {
+				t.Errorf("Ring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetwork(t *testing.T) {
+	// reads its address, waits for a packet and forwards it to address 255
+	forward := "3,100,3,101,1008,101,-1,102,1005,102,2,3,103,104,255,4,101,4,103,99"
+
+	network := intcode.NewNetwork(intcode.ParseProgram(forward), 3)
+	network.Send(intcode.Packet{Address: 1, X: 7, Y: 8})
+
+	var got []intcode.Packet
+	network.Run(func(p intcode.Packet) {
+		got = append(got, p)
+		network.Stop()
+	})
+
+	want := intcode.Packet{Address: 255, X: 7, Y: 8}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("Run() routed %v, want [%v]", got, want)
+	}
+}
+
+func TestNetworkSendUnbounded(t *testing.T) {
+	network := intcode.NewNetwork(intcode.ParseProgram("99"), 1)
+	for range 5000 {
+		network.Send(intcode.Packet{Address: 0, X: 1, Y: 2})
+	}
+	if network.Idle() {
+		t.Errorf("Idle() = true with queued packets")
+	}
+}
@@ -0,0 +1,73 @@
+package intcode
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Interactive plays an ASCII program by hand. Output lines are printed to w
+// and every line read from r is sent as command, except for lines starting
+// with ! which control the REPL itself:
+//
+//	!save NAME  snapshot the machine state
+//	!load NAME  restore a snapshot
+//	!list       list the snapshot names
+//	!quit       stop playing
+func Interactive(a *ASCII, r io.Reader, w io.Writer) error {
+	snapshots := map[string]Snapshot{}
+	scanner := bufio.NewScanner(r)
+	for {
+		for _, line := range a.Run() {
+			fmt.Fprintln(w, line)
+		}
+		if a.CPU.HasHalted() {
+			if result, ok := a.Result(); ok {
+				fmt.Fprintln(w, "Result:", result)
+			}
+			fmt.Fprintln(w, "Program halted")
+			return nil
+		}
+
+		fmt.Fprint(w, a.Pending(), "> ")
+		if !scanner.Scan() {
+			return scanner.Err()
+		}
+		command := scanner.Text()
+		if !strings.HasPrefix(command, "!") {
+			a.SendLine(command)
+			continue
+		}
+
+		fields := strings.Fields(command[1:])
+		if len(fields) == 0 {
+			fields = []string{""}
+		}
+		switch {
+		case fields[0] == "quit":
+			return nil
+		case fields[0] == "list":
+			names := []string{}
+			for name := range snapshots {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			fmt.Fprintln(w, strings.Join(names, " "))
+		case fields[0] == "save" && len(fields) == 2:
+			snapshots[fields[1]] = a.Snapshot()
+			fmt.Fprintln(w, "Saved", fields[1])
+		case fields[0] == "load" && len(fields) == 2:
+			snapshot, ok := snapshots[fields[1]]
+			if !ok {
+				fmt.Fprintln(w, "Unknown snapshot", fields[1])
+				continue
+			}
+			a.Restore(snapshot)
+			fmt.Fprintln(w, "Loaded", fields[1])
+		default:
+			fmt.Fprintln(w, "Commands: !save NAME, !load NAME, !list, !quit")
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package intcode
+
+import "slices"
+
+// Snapshot is a copy of the machine state that can be restored later
+type Snapshot struct {
+	memory             []int
+	instructionPointer int
+	relativeBase       int
+	inputs             []int
+	outputs            []int
+	halted             bool
+	// numeric results and the unterminated line collected by an ASCII wrapper
+	results []int
+	pending string
+}
+
+// Snapshot copies the current state, inputs that were already consumed are
+// not part of it
+func (c *CPU) Snapshot() Snapshot {
+	return Snapshot{
+		memory:             slices.Clone(c.memory),
+		instructionPointer: c.instructionPointer,
+		relativeBase:       c.relativeBase,
+		inputs:             slices.Clone(c.inputs[c.inputPointer:]),
+		outputs:            slices.Clone(c.outputs),
+		halted:             c.halted,
+	}
+}
+
+// Restore resets the CPU to the given snapshot, the snapshot stays usable
+func (c *CPU) Restore(s Snapshot) {
+	c.memory = slices.Clone(s.memory)
+	c.instructionPointer = s.instructionPointer
+	c.relativeBase = s.relativeBase
+	c.inputs = slices.Clone(s.inputs)
+	c.inputPointer = 0
+	c.outputs = slices.Clone(s.outputs)
+	c.halted = s.halted
+	c.waiting = false
+}
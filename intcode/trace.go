@@ -0,0 +1,107 @@
+package intcode
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+type EventKind int
+
+const (
+	EXEC EventKind = iota
+	WRITE
+	INPUT
+	OUTPUT
+)
+
+var eventNames = map[EventKind]string{
+	EXEC:   "exec",
+	WRITE:  "write",
+	INPUT:  "input",
+	OUTPUT: "output",
+}
+
+// Event is a single step recorded by a Trace. For EXEC Address is the
+// instruction pointer and Instruction the executed instruction, for WRITE
+// Address and Value describe the memory write, INPUT and OUTPUT only set Value.
+type Event struct {
+	Kind        EventKind
+	Address     int
+	Value       int
+	Instruction Instruction
+}
+
+func (e Event) String() string {
+	switch e.Kind {
+	case EXEC:
+		return fmt.Sprintf("%-6s %04d: %s", eventNames[e.Kind], e.Address, e.Instruction)
+	case WRITE:
+		return fmt.Sprintf("%-6s [%d] = %d", eventNames[e.Kind], e.Address, e.Value)
+	default:
+		return fmt.Sprintf("%-6s %d", eventNames[e.Kind], e.Value)
+	}
+}
+
+// Trace records the events of every CPU it is attached to via SetTracer
+type Trace struct {
+	mu     sync.Mutex
+	Events []Event
+}
+
+func (t *Trace) record(event Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Events = append(t.Events, event)
+}
+
+// Filter returns the recorded events of the given kinds
+func (t *Trace) Filter(kinds ...EventKind) []Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	events := []Event{}
+	for _, event := range t.Events {
+		for _, kind := range kinds {
+			if event.Kind == kind {
+				events = append(events, event)
+				break
+			}
+		}
+	}
+	return events
+}
+
+func (t *Trace) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	builder := strings.Builder{}
+	for _, event := range t.Events {
+		builder.WriteString(event.String())
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// WriteTo writes one line per recorded event
+func (t *Trace) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, t.String())
+	return int64(n), err
+}
+
+// Dump writes the trace to the given file
+func (t *Trace) Dump(filename string) error {
+	return os.WriteFile(filename, []byte(t.String()), os.FileMode(0644))
+}
+
+// SetTracer attaches a trace to the CPU, nil detaches it
+func (c *CPU) SetTracer(t *Trace) {
+	c.tracer = t
+}
+
+func (c *CPU) trace(event Event) {
+	if c.tracer != nil {
+		c.tracer.record(event)
+	}
+}
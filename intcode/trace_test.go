@@ -0,0 +1,45 @@
+package intcode_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/intcode"
+)
+
+func TestTrace(t *testing.T) {
+	cpu := intcode.NewCPU(intcode.ParseProgram("3,9,1001,9,2,9,4,9,99,0"), 40)
+	trace := &intcode.Trace{}
+	cpu.SetTracer(trace)
+	cpu.Run()
+
+	want := `exec   0000: IN -> [9]
+input  40
+write  [9] = 40
+exec   0002: ADD [9], #2 -> [9]
+write  [9] = 42
+exec   0006: OUT [9]
+output 42
+`
+	if got := trace.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	outputs := trace.Filter(intcode.OUTPUT)
+	if len(outputs) != 1 || outputs[0].Value != 42 {
+		t.Errorf("Filter(OUTPUT) = %v, want a single output of 42", outputs)
+	}
+
+	filename := filepath.Join(t.TempDir(), "trace.txt")
+	if err := trace.Dump(filename); err != nil {
+		t.Fatalf("Dump() error = %v", err)
+	}
+	dumped, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("reading dump: %v", err)
+	}
+	if string(dumped) != want {
+		t.Errorf("Dump() wrote %q, want %q", dumped, want)
+	}
+}
@@ -0,0 +1,242 @@
+// Package pulse simulates the module networks of AOC 2023 day 20, where
+// flip-flops, conjunctions and a broadcaster pass high and low pulses.
+package pulse
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+)
+
+type ModuleType int
+
+const (
+	NONE ModuleType = iota
+	BROADCASTER
+	FLIP_FLOP
+	CONJUNCTION
+)
+
+type Module struct {
+	Name      string
+	Type      ModuleType
+	Receivers []string
+
+	// flip-flop state
+	state bool
+	// last pulse remembered per input of a conjunction
+	senderStates map[string]bool
+}
+
+// Pulse is a single high or low pulse sent from Src to Dest
+type Pulse struct {
+	Src  string
+	Dest string
+	High bool
+}
+
+type Network struct {
+	modules map[string]*Module
+	inputs  map[string][]string
+	names   []string
+
+	LowCount  int
+	HighCount int
+	Presses   int
+}
+
+// Parse reads one module per line, e.g. %a -> inv, con
+func Parse(input string) *Network {
+	n := &Network{
+		modules: map[string]*Module{},
+		inputs:  map[string][]string{},
+	}
+
+	for _, line := range strings.Split(input, "\n") {
+		data := strings.Split(line, " -> ")
+		if len(data) != 2 {
+			panic(fmt.Sprintf("invalid module %q", line))
+		}
+
+		module := &Module{}
+		switch {
+		case strings.HasPrefix(data[0], "%"):
+			module.Type = FLIP_FLOP
+			module.Name = data[0][1:]
+		case strings.HasPrefix(data[0], "&"):
+			module.Type = CONJUNCTION
+			module.Name = data[0][1:]
+		default:
+			module.Type = BROADCASTER
+			module.Name = data[0]
+		}
+
+		for _, r := range strings.Split(data[1], ",") {
+			module.Receivers = append(module.Receivers, strings.Trim(r, " "))
+		}
+
+		n.modules[module.Name] = module
+		n.names = append(n.names, module.Name)
+	}
+	slices.Sort(n.names)
+
+	for _, name := range n.names {
+		for _, r := range n.modules[name].Receivers {
+			n.inputs[r] = append(n.inputs[r], name)
+		}
+	}
+	n.Reset()
+	return n
+}
+
+// Reset turns every flip-flop off, makes every conjunction remember low
+// pulses and clears the counters
+func (n *Network) Reset() {
+	for _, module := range n.modules {
+		module.state = false
+		if module.Type == CONJUNCTION {
+			module.senderStates = map[string]bool{}
+			for _, in := range n.inputs[module.Name] {
+				module.senderStates[in] = false
+			}
+		}
+	}
+	n.LowCount = 0
+	n.HighCount = 0
+	n.Presses = 0
+}
+
+// Module returns the module with the given name, ok is false for receivers
+// that are only named as destination (e.g. rx)
+func (n *Network) Module(name string) (module Module, ok bool) {
+	m, ok := n.modules[name]
+	if !ok {
+		return Module{}, false
+	}
+	return *m, true
+}
+
+// Inputs returns the names of the modules sending to name
+func (n *Network) Inputs(name string) []string {
+	return n.inputs[name]
+}
+
+// Press sends a low pulse to the broadcaster and processes pulses until
+// the network settles. The hooks are called for every pulse of this press.
+func (n *Network) Press(hooks ...func(Pulse)) {
+	n.Presses++
+	queue := []Pulse{{"button", "broadcaster", false}}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		if p.High {
+			n.HighCount++
+		} else {
+			n.LowCount++
+		}
+		for _, hook := range hooks {
+			hook(p)
+		}
+
+		module, ok := n.modules[p.Dest]
+		if !ok {
+			continue
+		}
+
+		var send bool
+		switch module.Type {
+		case BROADCASTER:
+			send = p.High
+		case FLIP_FLOP:
+			if p.High {
+				continue
+			}
+			module.state = !module.state
+			send = module.state
+		case CONJUNCTION:
+			module.senderStates[p.Src] = p.High
+			send = false
+			for _, v := range module.senderStates {
+				if !v {
+					send = true
+					break
+				}
+			}
+		}
+
+		for _, r := range module.Receivers {
+			queue = append(queue, Pulse{module.Name, r, send})
+		}
+	}
+}
+
+// CycleLengths presses the button until every input of the conjunction has
+// sent it a high pulse and returns the press count at which that happened
+// first per input. Each input is assumed to be a counter that fires with
+// this period, so the conjunction fires at their least common multiple.
+func (n *Network) CycleLengths(conjunction string, maxPresses int) (map[string]int, error) {
+	module, ok := n.modules[conjunction]
+	if !ok || module.Type != CONJUNCTION {
+		return nil, fmt.Errorf("%s is not a conjunction", conjunction)
+	}
+
+	cycles := map[string]int{}
+	hook := func(p Pulse) {
+		if p.Dest == conjunction && p.High {
+			if _, seen := cycles[p.Src]; !seen {
+				cycles[p.Src] = n.Presses
+			}
+		}
+	}
+
+	for len(cycles) < len(n.inputs[conjunction]) {
+		if n.Presses >= maxPresses {
+			return cycles, fmt.Errorf("no cycle for every input of %s after %d presses", conjunction, maxPresses)
+		}
+		n.Press(hook)
+	}
+	return cycles, nil
+}
+
+// Hash identifies the state of every flip-flop and conjunction memory
+func (n *Network) Hash() uint64 {
+	h := fnv.New64a()
+	bit := func(b bool) byte {
+		if b {
+			return '1'
+		}
+		return '0'
+	}
+
+	for _, name := range n.names {
+		module := n.modules[name]
+		switch module.Type {
+		case FLIP_FLOP:
+			h.Write([]byte{bit(module.state)})
+		case CONJUNCTION:
+			for _, in := range n.inputs[name] {
+				h.Write([]byte{bit(module.senderStates[in])})
+			}
+		}
+		h.Write([]byte{'|'})
+	}
+	return h.Sum64()
+}
+
+// FindRepeat presses the button until the network is in a state it was in
+// before. It returns the press count after which that state was first seen
+// and the length of the loop.
+func (n *Network) FindRepeat(maxPresses int) (start, length int, ok bool) {
+	seen := map[uint64]int{n.Hash(): n.Presses}
+	for n.Presses < maxPresses {
+		n.Press()
+		hash := n.Hash()
+		if first, found := seen[hash]; found {
+			return first, n.Presses - first, true
+		}
+		seen[hash] = n.Presses
+	}
+	return 0, 0, false
+}
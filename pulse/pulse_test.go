@@ -0,0 +1,120 @@
+package pulse_test
+
+import (
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/pulse"
+)
+
+var example = `broadcaster -> a, b, c
+%a -> b
+%b -> c
+%c -> inv
+&inv -> a`
+
+var example2 = `broadcaster -> a
+%a -> inv, con
+&inv -> b
+%b -> con
+&con -> output`
+
+func TestPress(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		presses  int
+		wantLow  int
+		wantHigh int
+	}{
+		{"example_once", example, 1, 8, 4},
+		{"example", example, 1000, 8000, 4000},
+		{"example2_once", example2, 1, 4, 4},
+		{"example2", example2, 1000, 4250, 2750},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := pulse.Parse(tt.input)
+			for range tt.presses {
+				n.Press()
+			}
+			if n.LowCount != tt.wantLow || n.HighCount != tt.wantHigh {
+				t.Errorf("counts = %v low, %v high, want %v low, %v high", n.LowCount, n.HighCount, tt.wantLow, tt.wantHigh)
+			}
+		})
+	}
+}
+
+func TestPressHooks(t *testing.T) {
+	n := pulse.Parse(example2)
+	var got []pulse.Pulse
+	n.Press(func(p pulse.Pulse) {
+		got = append(got, p)
+	})
+
+	want := []pulse.Pulse{
+		{"button", "broadcaster", false},
+		{"broadcaster", "a", false},
+		{"a", "inv", true},
+		{"a", "con", true},
+		{"inv", "b", false},
+		{"con", "output", true},
+		{"b", "con", true},
+		{"con", "output", false},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("hook saw %v pulses, want %v", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("pulse %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFindRepeat(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantStart  int
+		wantLength int
+	}{
+		{"example", example, 0, 1},
+		{"example2", example2, 0, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, length, ok := pulse.Parse(tt.input).FindRepeat(100)
+			if !ok || start != tt.wantStart || length != tt.wantLength {
+				t.Errorf("FindRepeat() = %v, %v, %v, want %v, %v, true", start, length, ok, tt.wantStart, tt.wantLength)
+			}
+		})
+	}
+}
+
+func TestCycleLengths(t *testing.T) {
+	// two counters of 2 and 3 bits firing into the final conjunction
+	input := `broadcaster -> a0, b0
+%a0 -> a1, ca
+%a1 -> ca
+&ca -> ia
+&ia -> out
+%b0 -> b1, cb
+%b1 -> b2, cb
+%b2 -> cb
+&cb -> ib
+&ib -> out
+&out -> rx`
+
+	n := pulse.Parse(input)
+	cycles, err := n.CycleLengths("out", 100)
+	if err != nil {
+		t.Fatalf("CycleLengths() error = %v", err)
+	}
+	if cycles["ia"] != 3 || cycles["ib"] != 7 || len(cycles) != 2 {
+		t.Errorf("CycleLengths() = %v, want map[ia:3 ib:7]", cycles)
+	}
+
+	if _, err := n.CycleLengths("a0", 100); err == nil {
+		t.Errorf("CycleLengths() of a flip-flop should fail")
+	}
+}
@@ -2,81 +2,106 @@
 package aoc
 
 import (
-	"context"
 	"flag"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 	"time"
+
+	"github.com/zMoooooritz/advent-of-code/util"
 )
 
+// ParseFlags parses the -day, -year, -cookie and -profile flags, day and
+// year default to the latest unlocked puzzle
 func ParseFlags() (day, year int, cookie string) {
-	today := time.Now()
-	flag.IntVar(&day, "day", today.Day(), "day number to fetch, 1-25")
-	flag.IntVar(&year, "year", today.Year(), "AOC year")
+	day, year, profile := ParseProfileFlags()
+	return day, year, profile.Cookie
+}
+
+// ParseProfileFlags is ParseFlags for commands that need to know whose
+// session they use. A -profile from the profiles file takes precedence over
+// -cookie (or AOC_SESSION_COOKIE), without either the default profile is used.
+func ParseProfileFlags() (day, year int, profile Profile) {
+	var cookie, name string
+	dayFlag, yearFlag := dayFlags()
 	// defaults to env variable
 	flag.StringVar(&cookie, "cookie", os.Getenv("AOC_SESSION_COOKIE"), "AOC session cookie")
+	flag.StringVar(&name, "profile", os.Getenv("AOC_PROFILE"), "session profile from the profiles file")
 	flag.Parse()
+	day, year = checkDay(*dayFlag, *yearFlag)
 
-	if day > 25 || day < 1 {
-		log.Fatalf("day out of range: %d", day)
+	profile, err := ResolveProfile(name, cookie)
+	if err != nil {
+		log.Fatalf("%s", err)
 	}
+	return day, year, profile
+}
+
+// ParseDayFlags is ParseFlags for commands that use no session or the
+// sessions of every profile
+func ParseDayFlags() (day, year int) {
+	dayFlag, yearFlag := dayFlags()
+	flag.Parse()
+	return checkDay(*dayFlag, *yearFlag)
+}
+
+func dayFlags() (day, year *int) {
+	currentDay, currentYear := CurrentPuzzle(time.Now())
+	day = flag.Int("day", currentDay, "day number to fetch, 1-25 (1-12 since 2025)")
+	year = flag.Int("year", currentYear, "AOC year")
+	return day, year
+}
 
+func checkDay(day, year int) (int, int) {
 	if year < 2015 {
 		log.Fatalf("year is before 2015: %d", year)
 	}
 
-	if cookie == "" {
-		log.Fatalf("no session cookie set on flag or env var (AOC_SESSION_COOKIE)")
+	if day > DaysIn(year) || day < 1 {
+		log.Fatalf("day out of range: %d, the %d event has %d puzzles", day, year, DaysIn(year))
 	}
-
-	return day, year, cookie
+	return day, year
 }
 
-func GetWithAOCCookie(url string, cookie string) []byte {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		log.Fatalf("making request: %s", err)
+// ResolveProfile returns the named profile from the profiles file, without a
+// name the cookie is used if set and the default profile otherwise
+func ResolveProfile(name, cookie string) (Profile, error) {
+	if name == "" && cookie != "" {
+		return Profile{Cookie: cookie, Default: true}, nil
 	}
 
-	sessionCookie := http.Cookie{
-		Name:  "session",
-		Value: cookie,
-	}
-	req.AddCookie(&sessionCookie)
-
-	res, err := http.DefaultClient.Do(req)
+	filename, err := DefaultProfilesFilename()
 	if err != nil {
-		log.Fatalf("making request: %s", err)
+		return Profile{}, err
 	}
-
-	body, err := io.ReadAll(res.Body)
+	profiles, err := LoadProfiles(filename)
 	if err != nil {
-		log.Fatalf("reading response body: %s", err)
+		return Profile{}, err
 	}
-	fmt.Println("response length is", len(body))
-
-	// specific error message from AOC site
-	if strings.HasPrefix(string(body), "Please don't repeatedly") {
-		log.Fatalf("Repeated request github.com/zMoooooritz/advent-of-code error")
+	if name == "" && profiles.Default == "" {
+		return Profile{}, fmt.Errorf("no session cookie set on flag or env var (AOC_SESSION_COOKIE) and no default profile in %s", filename)
+	}
+	profile, err := profiles.Get(name)
+	if err != nil {
+		return Profile{}, fmt.Errorf("%w in %s", err, filename)
 	}
+	return profile, nil
+}
 
-	return body
+// DayFilename returns the path of a file in the directory of the given day
+func DayFilename(day, year int, name string) string {
+	return filepath.Join(util.Dirname(), "../..", fmt.Sprintf("%d/day%02d/%s", year, day, name))
 }
 
-func WriteToFile(filename string, contents []byte) {
+func WriteToFile(filename string, contents []byte) error {
 	err := os.MkdirAll(filepath.Dir(filename), os.ModePerm)
 	if err != nil {
-		log.Fatalf("making directory: %s", err)
+		return fmt.Errorf("making directory: %w", err)
 	}
 	err = os.WriteFile(filename, contents, os.FileMode(0644))
 	if err != nil {
-		log.Fatalf("writing file: %s", err)
+		return fmt.Errorf("writing file: %w", err)
 	}
+	return nil
 }
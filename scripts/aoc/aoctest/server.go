@@ -0,0 +1,202 @@
+// Package aoctest provides a fake adventofcode.com server for offline tests.
+package aoctest
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Puzzle is the state of one day on the fake server
+type Puzzle struct {
+	Title   string
+	Input   string
+	Parts   []string // HTML of the day-desc articles
+	Answers []string // correct answers per part
+	Locked  bool
+}
+
+// Server serves inputs, puzzle pages and answer submissions for the puzzles
+// it knows. Requests without the expected session cookie are rejected like
+// on the real site.
+type Server struct {
+	*httptest.Server
+
+	Session string
+	// Cooldown makes every submission after the first one answer with a
+	// "You gave an answer too recently" response
+	Cooldown bool
+
+	mu          sync.Mutex
+	puzzles     map[[2]int]*Puzzle
+	pages       map[string]string
+	solved      map[[2]int]int
+	submissions int
+	requests    []Request
+}
+
+// Request is a request the server received
+type Request struct {
+	Method    string
+	Path      string
+	UserAgent string
+	Form      map[string][]string
+}
+
+func NewServer(session string) *Server {
+	s := &Server{
+		Session: session,
+		puzzles: map[[2]int]*Puzzle{},
+		pages:   map[string]string{},
+		solved:  map[[2]int]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddPuzzle makes the puzzle available for the given day
+func (s *Server) AddPuzzle(day, year int, puzzle Puzzle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.puzzles[[2]int{year, day}] = &puzzle
+}
+
+// AddPage serves body at path to logged in users, e.g. a leaderboard
+func (s *Server) AddPage(path, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pages[path] = body
+}
+
+// Solved returns the number of solved parts of the given day
+func (s *Server) Solved(day, year int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.solved[[2]int{year, day}]
+}
+
+// Requests returns every request received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+var pathPattern = regexp.MustCompile(`^/(\d{4})/day/(\d{1,2})(/input|/answer)?$`)
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, Request{r.Method, r.URL.Path, r.UserAgent(), r.PostForm})
+
+	cookie, err := r.Cookie("session")
+	loggedIn := err == nil && cookie.Value == s.Session
+
+	if page, ok := s.pages[r.URL.Path]; ok {
+		if !loggedIn {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, page)
+		return
+	}
+
+	match := pathPattern.FindStringSubmatch(r.URL.Path)
+	if match == nil {
+		http.NotFound(w, r)
+		return
+	}
+	year, _ := strconv.Atoi(match[1])
+	day, _ := strconv.Atoi(match[2])
+	key := [2]int{year, day}
+
+	puzzle, ok := s.puzzles[key]
+	if !ok || puzzle.Locked {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, "Please don't repeatedly request this endpoint before it unlocks! The calendar countdown is synchronized with the server time; the link will be enabled on the calendar the instant this puzzle becomes available.\n")
+		return
+	}
+
+	switch match[3] {
+	case "/input":
+		if !loggedIn {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.\n")
+			return
+		}
+		fmt.Fprint(w, puzzle.Input)
+	case "/answer":
+		if r.Method != http.MethodPost || !loggedIn {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.writePage(w, puzzle, day, year, loggedIn, s.submit(key, puzzle, r.PostForm.Get("level"), r.PostForm.Get("answer")))
+	default:
+		s.writePage(w, puzzle, day, year, loggedIn, "")
+	}
+}
+
+// submit returns the message of the answer article
+func (s *Server) submit(key [2]int, puzzle *Puzzle, level, answer string) string {
+	solved := s.solved[key]
+	s.submissions++
+	if s.Cooldown && s.submissions > 1 {
+		return "You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait."
+	}
+	if level != strconv.Itoa(solved+1) || solved >= len(puzzle.Answers) {
+		return "You don't seem to be solving the right level.  Did you already complete it?"
+	}
+
+	want := puzzle.Answers[solved]
+	if strings.TrimSpace(answer) == want {
+		s.solved[key] = solved + 1
+		return "That's the right answer!  You are <span class=\"day-success\">one gold star</span> closer to saving Christmas."
+	}
+
+	got, gotOk := new(big.Int).SetString(strings.TrimSpace(answer), 10)
+	correct, correctOk := new(big.Int).SetString(want, 10)
+	switch {
+	case gotOk && correctOk && got.Cmp(correct) > 0:
+		return "That's not the right answer; your answer is too high.  Please wait one minute before trying again."
+	case gotOk && correctOk:
+		return "That's not the right answer; your answer is too low.  Please wait one minute before trying again."
+	default:
+		return "That's not the right answer.  Please wait one minute before trying again."
+	}
+}
+
+func (s *Server) writePage(w http.ResponseWriter, puzzle *Puzzle, day, year int, loggedIn bool, message string) {
+	fmt.Fprintf(w, "<!DOCTYPE html>\n<html lang=\"en-us\">\n<head>\n<title>Day %d - Advent of Code %d</title>\n</head>\n<body>\n<main>\n", day, year)
+	if message != "" {
+		fmt.Fprintf(w, "<article><p>%s</p></article>\n", message)
+		fmt.Fprint(w, "</main>\n</body>\n</html>\n")
+		return
+	}
+
+	solved := s.solved[[2]int{year, day}]
+	visible := 1
+	if loggedIn {
+		visible = min(solved+1, len(puzzle.Parts))
+	}
+	for index, part := range puzzle.Parts[:visible] {
+		if index == 0 {
+			fmt.Fprintf(w, "<article class=\"day-desc\"><h2>--- Day %d: %s ---</h2>%s</article>\n", day, puzzle.Title, part)
+		} else {
+			fmt.Fprintf(w, "<article class=\"day-desc\"><h2 id=\"part2\">--- Part Two ---</h2>%s</article>\n", part)
+		}
+		if loggedIn && index < solved {
+			fmt.Fprintf(w, "<p>Your puzzle answer was <code>%s</code>.</p>", puzzle.Answers[index])
+		}
+	}
+	if loggedIn && solved < len(puzzle.Answers) {
+		fmt.Fprintf(w, "<form method=\"post\" action=\"%d/answer\"><input type=\"hidden\" name=\"level\" value=\"%d\"/><p>Answer: <input type=\"text\" name=\"answer\" autocomplete=\"off\"/> <input type=\"submit\" value=\"[Submit]\"/></p></form>\n", day, solved+1)
+	}
+	fmt.Fprint(w, "</main>\n</body>\n</html>\n")
+}
@@ -0,0 +1,157 @@
+package aoc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// InputMeta describes a cached input
+type InputMeta struct {
+	Year    int       `json:"year"`
+	Day     int       `json:"day"`
+	Session string    `json:"session"`
+	Fetched time.Time `json:"fetched"`
+	Length  int       `json:"length"`
+	SHA256  string    `json:"sha256"`
+}
+
+// InputCache stores fetched inputs content-addressed by their sha256, with
+// an index entry per year, day and session so inputs are only fetched once
+type InputCache struct {
+	Dir string
+}
+
+// DefaultInputCache is located in the user cache dir
+func DefaultInputCache() (*InputCache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("finding user cache dir: %w", err)
+	}
+	return &InputCache{filepath.Join(dir, "advent-of-code", "inputs")}, nil
+}
+
+// SessionID identifies a session cookie without storing the cookie itself
+func SessionID(cookie string) string {
+	sum := sha256.Sum256([]byte(cookie))
+	return hex.EncodeToString(sum[:8])
+}
+
+func (c *InputCache) blobFilename(sum string) string {
+	return filepath.Join(c.Dir, "blobs", sum)
+}
+
+func (c *InputCache) metaFilename(day, year int, session string) string {
+	return filepath.Join(c.Dir, session, fmt.Sprintf("%d-day%02d.json", year, day))
+}
+
+// Lookup returns the cached input, ok is false if it was never stored
+func (c *InputCache) Lookup(day, year int, session string) (content []byte, meta InputMeta, ok bool, err error) {
+	raw, err := os.ReadFile(c.metaFilename(day, year, session))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, InputMeta{}, false, nil
+	}
+	if err != nil {
+		return nil, InputMeta{}, false, fmt.Errorf("reading cache: %w", err)
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, InputMeta{}, false, fmt.Errorf("parsing cache entry: %w", err)
+	}
+
+	content, err = os.ReadFile(c.blobFilename(meta.SHA256))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, InputMeta{}, false, nil
+	}
+	if err != nil {
+		return nil, InputMeta{}, false, fmt.Errorf("reading cache: %w", err)
+	}
+	if sum := sha256.Sum256(content); hex.EncodeToString(sum[:]) != meta.SHA256 {
+		return nil, InputMeta{}, false, fmt.Errorf("cached input %s is corrupted", meta.SHA256)
+	}
+	return content, meta, true, nil
+}
+
+// Store adds the input to the cache and returns its metadata
+func (c *InputCache) Store(day, year int, session string, content []byte) (InputMeta, error) {
+	sum := sha256.Sum256(content)
+	meta := InputMeta{
+		Year:    year,
+		Day:     day,
+		Session: session,
+		Fetched: time.Now().UTC(),
+		Length:  len(content),
+		SHA256:  hex.EncodeToString(sum[:]),
+	}
+
+	if err := WriteToFile(c.blobFilename(meta.SHA256), content); err != nil {
+		return InputMeta{}, err
+	}
+	raw, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return InputMeta{}, err
+	}
+	if err := WriteToFile(c.metaFilename(day, year, session), raw); err != nil {
+		return InputMeta{}, err
+	}
+	return meta, nil
+}
+
+// LeaderboardInterval is how long a fetched private leaderboard is served
+// from the cache, AOC asks to not request it more often
+const LeaderboardInterval = 15 * time.Minute
+
+// cachedLeaderboard is the cache entry of a private leaderboard
+type cachedLeaderboard struct {
+	Fetched time.Time       `json:"fetched"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// LeaderboardCache stores the JSON of fetched private leaderboards with the
+// time they were fetched, per year, id and session
+type LeaderboardCache struct {
+	Dir string
+}
+
+// DefaultLeaderboardCache is located in the user cache dir
+func DefaultLeaderboardCache() (*LeaderboardCache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("finding user cache dir: %w", err)
+	}
+	return &LeaderboardCache{filepath.Join(dir, "advent-of-code", "leaderboards")}, nil
+}
+
+func (c *LeaderboardCache) filename(year, id int, session string) string {
+	return filepath.Join(c.Dir, session, fmt.Sprintf("%d-%d.json", year, id))
+}
+
+// Lookup returns the cached leaderboard JSON and when it was fetched, ok is
+// false if it was never stored
+func (c *LeaderboardCache) Lookup(year, id int, session string) (body []byte, fetched time.Time, ok bool, err error) {
+	raw, err := os.ReadFile(c.filename(year, id, session))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, time.Time{}, false, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, false, fmt.Errorf("reading cache: %w", err)
+	}
+	entry := cachedLeaderboard{}
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, time.Time{}, false, fmt.Errorf("parsing cache entry: %w", err)
+	}
+	return entry.Body, entry.Fetched, true, nil
+}
+
+// Store adds the leaderboard JSON fetched at the given time to the cache
+func (c *LeaderboardCache) Store(year, id int, session string, body []byte, fetched time.Time) error {
+	raw, err := json.Marshal(cachedLeaderboard{fetched.UTC(), body})
+	if err != nil {
+		return err
+	}
+	return WriteToFile(c.filename(year, id, session), raw)
+}
@@ -0,0 +1,111 @@
+package aoc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveInputCache(t *testing.T) {
+	server := newTestServer(t)
+	client := newTestClient(server, "secret")
+	cache := &InputCache{filepath.Join(t.TempDir(), "cache")}
+	filename := filepath.Join(t.TempDir(), "input.txt")
+
+	meta, err := client.SaveInput(1, 2024, filename, cache, false)
+	if err != nil {
+		t.Fatalf("SaveInput() error = %v", err)
+	}
+	if meta.Length != 12 || len(meta.SHA256) != 64 || meta.Fetched.IsZero() {
+		t.Errorf("SaveInput() meta = %+v", meta)
+	}
+
+	// cached, no further request
+	os.Remove(filename)
+	if _, err := client.SaveInput(1, 2024, filename, cache, false); err != nil {
+		t.Fatalf("SaveInput() from cache error = %v", err)
+	}
+	if got := len(server.Requests()); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+
+	// other sessions don't share the cache entry
+	other := newTestClient(server, "other")
+	if _, _, err := other.CachedInput(1, 2024, cache, false); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("CachedInput() for other session error = %v, want ErrUnauthorized", err)
+	}
+
+	os.WriteFile(filename, []byte("edited\n"), 0644)
+	if _, err := client.SaveInput(1, 2024, filename, cache, false); !errors.Is(err, ErrInputDiffers) {
+		t.Errorf("SaveInput() over differing file error = %v, want ErrInputDiffers", err)
+	}
+
+	if _, err := client.SaveInput(1, 2024, filename, cache, true); err != nil {
+		t.Fatalf("SaveInput() forced error = %v", err)
+	}
+	content, _ := os.ReadFile(filename)
+	if string(content) != "3   4\n4   3\n" {
+		t.Errorf("SaveInput() forced wrote %q", content)
+	}
+	if got := len(server.Requests()); got != 3 {
+		t.Errorf("server received %d requests, want 3", got)
+	}
+}
+
+func TestInputCacheCorrupted(t *testing.T) {
+	cache := &InputCache{t.TempDir()}
+	meta, err := cache.Store(1, 2024, "session", []byte("1 2 3"))
+	if err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+
+	content, _, ok, err := cache.Lookup(1, 2024, "session")
+	if err != nil || !ok || string(content) != "1 2 3" {
+		t.Fatalf("Lookup() = %q, %v, %v", content, ok, err)
+	}
+
+	os.WriteFile(cache.blobFilename(meta.SHA256), []byte("changed"), 0644)
+	if _, _, _, err := cache.Lookup(1, 2024, "session"); err == nil {
+		t.Errorf("Lookup() of corrupted blob should fail")
+	}
+}
+
+func TestCachedLeaderboard(t *testing.T) {
+	server := newTestServer(t)
+	fixture, err := os.ReadFile("testdata/leaderboard.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.AddPage("/2024/leaderboard/private/view/1.json", string(fixture))
+
+	now := time.Date(2024, 12, 5, 12, 0, 0, 0, time.UTC)
+	client := newTestClient(server, "secret")
+	client.now = func() time.Time { return now }
+	cache := &LeaderboardCache{t.TempDir()}
+
+	steps := []struct {
+		after    time.Duration
+		requests int
+	}{
+		{0, 1},
+		{time.Minute, 1},
+		{13 * time.Minute, 1},
+		{time.Minute, 2},
+		{time.Minute, 2},
+	}
+	for _, step := range steps {
+		now = now.Add(step.after)
+		leaderboard, _, err := client.CachedLeaderboard(2024, 1, cache)
+		if err != nil {
+			t.Fatalf("CachedLeaderboard() error = %v", err)
+		}
+		if len(leaderboard.Members) != 3 {
+			t.Errorf("CachedLeaderboard() = %+v", leaderboard)
+		}
+		if got := len(server.Requests()); got != step.requests {
+			t.Errorf("after %s server received %d requests, want %d", step.after, got, step.requests)
+		}
+	}
+}
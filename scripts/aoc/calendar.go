@@ -0,0 +1,37 @@
+package aoc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var calendarDayRegex = regexp.MustCompile(`aria-label="Day (\d+)(?:, (one star|two stars))?"`)
+
+// Calendar fetches the calendar page of the year and returns the number of
+// stars per unlocked day
+func (c *Client) Calendar(year int) (map[int]int, error) {
+	body, err := c.Get(fmt.Sprintf("/%d", year))
+	if err != nil {
+		return nil, fmt.Errorf("fetching calendar: %w", err)
+	}
+	return ParseCalendar(body), nil
+}
+
+// ParseCalendar reads the star counts from the aria labels of the calendar
+// links, days that are not unlocked yet have no link and are missing
+func ParseCalendar(page []byte) map[int]int {
+	stars := map[int]int{}
+	for _, match := range calendarDayRegex.FindAllSubmatch(page, -1) {
+		day, _ := strconv.Atoi(string(match[1]))
+		switch string(match[2]) {
+		case "one star":
+			stars[day] = 1
+		case "two stars":
+			stars[day] = 2
+		default:
+			stars[day] = 0
+		}
+	}
+	return stars
+}
@@ -0,0 +1,22 @@
+package aoc
+
+import (
+	"maps"
+	"testing"
+)
+
+var calendarPage = `<main>
+<pre class="calendar calendar-perfect">
+<a aria-label="Day 1, two stars" href="/2024/day/1" class="calendar-day1 calendar-verycomplete">  <span class="calendar-day"> 1</span> <span class="calendar-mark-complete">*</span><span class="calendar-mark-verycomplete">*</span></a>
+<a aria-label="Day 2, one star" href="/2024/day/2" class="calendar-day2 calendar-complete">  <span class="calendar-day"> 2</span> <span class="calendar-mark-complete">*</span><span class="calendar-mark-verycomplete">*</span></a>
+<a aria-label="Day 3" href="/2024/day/3" class="calendar-day3">  <span class="calendar-day"> 3</span> <span class="calendar-mark-complete">*</span><span class="calendar-mark-verycomplete">*</span></a>
+<span aria-hidden="true" class="calendar-day4">                                                   <span class="calendar-day"> 4</span></span>
+</pre>
+</main>`
+
+func TestParseCalendar(t *testing.T) {
+	want := map[int]int{1: 2, 2: 1, 3: 0}
+	if got := ParseCalendar([]byte(calendarPage)); !maps.Equal(got, want) {
+		t.Errorf("ParseCalendar() = %v, want %v", got, want)
+	}
+}
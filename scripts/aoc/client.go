@@ -0,0 +1,138 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL   = "https://adventofcode.com"
+	DefaultUserAgent = "github.com/zMoooooritz/advent-of-code"
+	DefaultTimeout   = 10 * time.Second
+)
+
+var (
+	// ErrUnauthorized is returned if the session cookie is missing or invalid
+	ErrUnauthorized = errors.New("not logged in, check the session cookie")
+	// ErrLocked is returned for puzzles that are not unlocked yet
+	ErrLocked = errors.New("puzzle is not unlocked yet")
+	// ErrNoPuzzle is returned for days beyond the last puzzle of an event
+	ErrNoPuzzle = errors.New("no such puzzle")
+	// ErrRepeated is returned if the server asks to stop repeating a request
+	ErrRepeated = errors.New("repeated request rejected")
+)
+
+// HTTPError is returned for responses with an unexpected status code
+type HTTPError struct {
+	StatusCode int
+	URL        string
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("%s returned %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+// Client talks to adventofcode.com, or any server mimicking it
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+	UserAgent  string
+	Timeout    time.Duration
+	Cookie     string
+	// Limiter throttles every request, nil disables throttling
+	Limiter *RateLimiter
+
+	now func() time.Time
+}
+
+func NewClient(cookie string) *Client {
+	return &Client{
+		BaseURL:    DefaultBaseURL,
+		HTTPClient: http.DefaultClient,
+		UserAgent:  DefaultUserAgent,
+		Timeout:    DefaultTimeout,
+		Cookie:     cookie,
+		Limiter:    DefaultRateLimiter(),
+	}
+}
+
+// Get requests the path relative to the base URL, e.g. /2024/day/1/input
+func (c *Client) Get(path string) ([]byte, error) {
+	return c.do("GET", path, nil)
+}
+
+// Post sends the form url encoded to the path relative to the base URL
+func (c *Client) Post(path string, form url.Values) ([]byte, error) {
+	return c.do("POST", path, form)
+}
+
+func (c *Client) do(method, path string, form url.Values) ([]byte, error) {
+	if c.Limiter != nil {
+		if err := c.Limiter.Wait(method == "POST"); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := context.Background()
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	var reqBody io.Reader
+	if form != nil {
+		reqBody = strings.NewReader(form.Encode())
+	}
+	endpoint := strings.TrimRight(c.BaseURL, "/") + path
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("making request: %w", err)
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Cookie})
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("making request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	// specific error messages from AOC site
+	text := string(body)
+	switch {
+	case strings.HasPrefix(text, "Please don't repeatedly request this endpoint before it unlocks"):
+		return nil, fmt.Errorf("%s: %w", path, ErrLocked)
+	case strings.HasPrefix(text, "Please don't repeatedly"):
+		return nil, fmt.Errorf("%s: %w", path, ErrRepeated)
+	case strings.HasPrefix(text, "Puzzle inputs differ by user"):
+		return nil, fmt.Errorf("%s: %w", path, ErrUnauthorized)
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden:
+		return nil, fmt.Errorf("%s: %w", path, ErrUnauthorized)
+	case res.StatusCode != http.StatusOK:
+		return nil, &HTTPError{res.StatusCode, endpoint, strings.TrimSpace(text)}
+	}
+
+	return body, nil
+}
@@ -0,0 +1,179 @@
+package aoc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc/aoctest"
+)
+
+func newTestServer(t *testing.T) *aoctest.Server {
+	t.Helper()
+	server := aoctest.NewServer("secret")
+	t.Cleanup(server.Close)
+
+	server.AddPuzzle(1, 2024, aoctest.Puzzle{
+		Title:   "Historian Hysteria",
+		Input:   "3   4\n4   3\n",
+		Parts:   []string{"<p>Find the <em>total distance</em>.</p>", "<p>Find the <em>similarity score</em>.</p>"},
+		Answers: []string{"11", "31"},
+	})
+	server.AddPuzzle(2, 2024, aoctest.Puzzle{Locked: true})
+	return server
+}
+
+func newTestClient(server *aoctest.Server, cookie string) *Client {
+	client := NewClient(cookie)
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+	client.UserAgent = "aoc-test"
+	client.Limiter = nil
+	return client
+}
+
+func TestClientInput(t *testing.T) {
+	server := newTestServer(t)
+
+	filename := filepath.Join(t.TempDir(), "2024/day01/input.txt")
+	if _, err := newTestClient(server, "secret").SaveInput(1, 2024, filename, nil, false); err != nil {
+		t.Fatalf("SaveInput() error = %v", err)
+	}
+	content, _ := os.ReadFile(filename)
+	if string(content) != "3   4\n4   3\n" {
+		t.Errorf("SaveInput() wrote %q", content)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 || requests[0].Path != "/2024/day/1/input" || requests[0].UserAgent != "aoc-test" {
+		t.Errorf("server received %+v", requests)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name   string
+		cookie string
+		path   string
+		want   error
+	}{
+		{"unauthorized", "wrong", "/2024/day/1/input", ErrUnauthorized},
+		{"locked", "secret", "/2024/day/2/input", ErrLocked},
+		{"unknown_day", "secret", "/2024/day/3", ErrLocked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestClient(server, tt.cookie).Get(tt.path)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Get() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	_, err := newTestClient(server, "secret").Get("/nowhere")
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != 404 {
+		t.Errorf("Get() error = %v, want HTTPError with status 404", err)
+	}
+}
+
+func TestClientPrompt(t *testing.T) {
+	server := newTestServer(t)
+
+	filename := filepath.Join(t.TempDir(), "prompt.md")
+	if err := newTestClient(server, "secret").SavePrompt(1, 2024, filename); err != nil {
+		t.Fatalf("SavePrompt() error = %v", err)
+	}
+	content, _ := os.ReadFile(filename)
+	if !strings.Contains(string(content), "Historian Hysteria") || !strings.Contains(string(content), "total distance") {
+		t.Errorf("SavePrompt() wrote %q", content)
+	}
+	if strings.Contains(string(content), "similarity score") {
+		t.Errorf("SavePrompt() wrote part two before it was unlocked: %q", content)
+	}
+}
+
+func TestClientSubmit(t *testing.T) {
+	server := newTestServer(t)
+	client := newTestClient(server, "secret")
+	ledger := &Ledger{}
+
+	steps := []struct {
+		level   int
+		answer  string
+		want    Verdict
+		refused bool
+	}{
+		{1, "20", TOO_HIGH, false},
+		{1, "25", 0, true},
+		{1, "5", TOO_LOW, false},
+		{1, "11", CORRECT, false},
+		{1, "11", 0, true},
+		{2, "abc", WRONG, false},
+		{2, "31", CORRECT, false},
+	}
+	for _, step := range steps {
+		result, err := client.Submit(1, 2024, step.level, step.answer, ledger)
+		if step.refused {
+			if !errors.Is(err, ErrRefused) {
+				t.Errorf("Submit(%d, %s) error = %v, want refused", step.level, step.answer, err)
+			}
+			continue
+		}
+		if err != nil || result.Verdict != step.want {
+			t.Errorf("Submit(%d, %s) = %v, %v, want %v", step.level, step.answer, result, err, step.want)
+		}
+	}
+
+	if got := len(server.Requests()); got != 5 {
+		t.Errorf("server received %d submissions, want 5", got)
+	}
+	if got := server.Solved(1, 2024); got != 2 {
+		t.Errorf("server has %d solved parts, want 2", got)
+	}
+}
+
+func TestClientSubmitCooldown(t *testing.T) {
+	server := newTestServer(t)
+	server.Cooldown = true
+	client := newTestClient(server, "secret")
+	ledger := &Ledger{}
+
+	client.Submit(1, 2024, 1, "20", ledger)
+	result, err := client.Submit(1, 2024, 1, "11", ledger)
+	if err != nil || result.Verdict != WAIT || result.Wait.Seconds() != 34 {
+		t.Errorf("Submit() = %v, %v, want wait 34s", result, err)
+	}
+}
+
+func TestClientLeaderboard(t *testing.T) {
+	server := newTestServer(t)
+	fixture, err := os.ReadFile("testdata/leaderboard.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.AddPage("/2024/leaderboard/private/view/1.json", string(fixture))
+	server.AddPage("/2024/leaderboard/self", statsPage)
+
+	client := newTestClient(server, "secret")
+	leaderboard, err := client.PrivateLeaderboard(2024, 1)
+	if err != nil {
+		t.Fatalf("PrivateLeaderboard() error = %v", err)
+	}
+	if len(leaderboard.Members) != 3 || leaderboard.Event != "2024" {
+		t.Errorf("PrivateLeaderboard() = %+v", leaderboard)
+	}
+
+	stats, err := client.PersonalStats(2024)
+	if err != nil || len(stats) != 3 {
+		t.Errorf("PersonalStats() = %+v, %v", stats, err)
+	}
+
+	if _, err := newTestClient(server, "wrong").PrivateLeaderboard(2024, 1); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("PrivateLeaderboard() error = %v, want %v", err, ErrUnauthorized)
+	}
+}
@@ -0,0 +1,60 @@
+package aoc
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// PartExamples holds the candidate examples of one unlocked part: the
+// contents of its <pre><code> blocks and the values of its <code><em> nodes
+type PartExamples struct {
+	Inputs  []string
+	Answers []string
+}
+
+// Input returns the first example block, which is the example input in
+// nearly every puzzle
+func (p PartExamples) Input() (string, bool) {
+	if len(p.Inputs) == 0 {
+		return "", false
+	}
+	return p.Inputs[0], true
+}
+
+// Answer returns the last highlighted code value, puzzles end the example
+// walkthrough with its expected answer
+func (p PartExamples) Answer() (string, bool) {
+	if len(p.Answers) == 0 {
+		return "", false
+	}
+	return p.Answers[len(p.Answers)-1], true
+}
+
+// ParseExamples returns the candidate examples of every day-desc article of
+// the puzzle page, so the length is the number of unlocked parts
+func ParseExamples(page []byte) []PartExamples {
+	node, err := html.Parse(bytes.NewReader(page))
+	if err != nil {
+		return nil
+	}
+
+	parts := []PartExamples{}
+	for _, ddNode := range dfsHTML(node, cbFindDayDescClass) {
+		part := PartExamples{}
+		dfsHTML(ddNode.(*html.Node), func(n *html.Node) []interface{} {
+			if n.Type != html.ElementNode || n.Data != "code" {
+				return nil
+			}
+			if n.Parent != nil && n.Parent.Type == html.ElementNode && n.Parent.Data == "pre" {
+				part.Inputs = append(part.Inputs, strings.TrimRight(textContent(n), "\n"))
+			} else if hasElement(n, "em") {
+				part.Answers = append(part.Answers, strings.TrimSpace(textContent(n)))
+			}
+			return nil
+		})
+		parts = append(parts, part)
+	}
+	return parts
+}
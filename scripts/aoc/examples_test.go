@@ -0,0 +1,30 @@
+package aoc
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseExamples(t *testing.T) {
+	parts := ParseExamples([]byte(puzzlePage))
+	if len(parts) != 2 {
+		t.Fatalf("ParseExamples() found %d parts, want 2", len(parts))
+	}
+
+	if input, ok := parts[0].Input(); !ok || input != "3   4\n4   3\n2   5" {
+		t.Errorf("part 1 Input() = %q, %v", input, ok)
+	}
+	if answer, ok := parts[0].Answer(); !ok || answer != "11" {
+		t.Errorf("part 1 Answer() = %q, %v, want 11", answer, ok)
+	}
+	if !slices.Equal(parts[0].Answers, []string{"11"}) {
+		t.Errorf("part 1 Answers = %q, want [11]", parts[0].Answers)
+	}
+
+	if _, ok := parts[1].Input(); ok {
+		t.Errorf("part 2 Input() found an example in %q", parts[1].Inputs)
+	}
+	if _, ok := parts[1].Answer(); ok {
+		t.Errorf("part 2 Answer() found an answer in %q", parts[1].Answers)
+	}
+}
@@ -1,28 +1,86 @@
 package aoc
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
-	"path/filepath"
-	"strings"
-
-	"github.com/zMoooooritz/advent-of-code/util"
+	"log"
+	"os"
+	"time"
 )
 
-func GetInput(day, year int, cookie string) {
-	fmt.Printf("fetching for day %d, year %d\n", day, year)
+// ErrInputDiffers is returned instead of overwriting an input file with
+// different content
+var ErrInputDiffers = errors.New("input file differs from the fetched input")
+
+// Input fetches the puzzle input of the given day
+func (c *Client) Input(day, year int) ([]byte, error) {
+	if err := c.checkUnlocked(day, year); err != nil {
+		return nil, err
+	}
+	return c.Get(fmt.Sprintf("/%d/day/%d/input", year, day))
+}
 
-	// make the request
-	url := fmt.Sprintf("https://adventofcode.com/%d/day/%d/input", year, day)
-	body := GetWithAOCCookie(url, cookie)
+// CachedInput returns the input from the cache and only fetches it if it is
+// not cached yet or force is set. cache may be nil to always fetch.
+func (c *Client) CachedInput(day, year int, cache *InputCache, force bool) ([]byte, InputMeta, error) {
+	session := SessionID(c.Cookie)
+	if cache != nil && !force {
+		content, meta, ok, err := cache.Lookup(day, year, session)
+		if err != nil {
+			return nil, InputMeta{}, err
+		}
+		if ok {
+			return content, meta, nil
+		}
+	}
 
-	if strings.HasPrefix(string(body), "Puzzle inputs differ by user") {
-		panic("'Puzzle inputs differ by user' response")
+	content, err := c.Input(day, year)
+	if err != nil {
+		return nil, InputMeta{}, fmt.Errorf("fetching input: %w", err)
+	}
+	if cache == nil {
+		return content, InputMeta{Year: year, Day: day, Session: session, Length: len(content)}, nil
+	}
+	meta, err := cache.Store(day, year, session, content)
+	if err != nil {
+		return nil, InputMeta{}, fmt.Errorf("caching input: %w", err)
 	}
+	return content, meta, nil
+}
 
-	// write to file
-	filename := filepath.Join(util.Dirname(), "../..", fmt.Sprintf("%d/day%02d/input.txt", year, day))
-	WriteToFile(filename, body)
+// SaveInput writes the (cached) input to filename. An existing file with
+// different content is only overwritten if force is set, which also
+// refetches the input.
+func (c *Client) SaveInput(day, year int, filename string, cache *InputCache, force bool) (InputMeta, error) {
+	content, meta, err := c.CachedInput(day, year, cache, force)
+	if err != nil {
+		return InputMeta{}, err
+	}
+
+	existing, err := os.ReadFile(filename)
+	if err == nil && !bytes.Equal(existing, content) && !force {
+		return meta, fmt.Errorf("%s: %w, use -force to overwrite", filename, ErrInputDiffers)
+	}
+	return meta, WriteToFile(filename, content)
+}
+
+// GetInput writes the input of the profile to its input file of the day
+func GetInput(day, year int, profile Profile, force bool) {
+	fmt.Printf("fetching for day %d, year %d\n", day, year)
+
+	cache, err := DefaultInputCache()
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	filename := DayFilename(day, year, profile.InputName())
+	meta, err := NewClient(profile.Cookie).SaveInput(day, year, filename, cache, force)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
 
+	fmt.Printf("input fetched %s, %d bytes, sha256 %s\n", meta.Fetched.Local().Format(time.DateTime), meta.Length, meta.SHA256)
 	fmt.Println("Wrote to file: ", filename)
 
 	fmt.Println("Done!")
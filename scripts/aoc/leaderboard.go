@@ -0,0 +1,258 @@
+package aoc
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Leaderboard is the JSON API of a private leaderboard
+type Leaderboard struct {
+	Event   string            `json:"event"`
+	OwnerID int               `json:"owner_id"`
+	Day1TS  int64             `json:"day1_ts"`
+	Members map[string]Member `json:"members"`
+}
+
+// Member is a single participant of a private leaderboard
+type Member struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Stars       int    `json:"stars"`
+	LocalScore  int    `json:"local_score"`
+	GlobalScore int    `json:"global_score"`
+	LastStarTS  int64  `json:"last_star_ts"`
+	// day -> part -> star
+	Completion map[int]map[int]Star `json:"completion_day_level"`
+}
+
+type Star struct {
+	GetStarTS int64 `json:"get_star_ts"`
+	StarIndex int64 `json:"star_index"`
+}
+
+// Standing is the position of a member on the leaderboard after a day
+type Standing struct {
+	Member Member
+	Rank   int
+	Score  int
+	Stars  int
+	// positive if the member moved up compared to the day before
+	Change int
+}
+
+// PrivateLeaderboard fetches the private leaderboard with the given id, AOC
+// asks to not request it more often than once every 15 minutes, so use
+// CachedLeaderboard instead of calling it repeatedly
+func (c *Client) PrivateLeaderboard(year, id int) (*Leaderboard, error) {
+	body, err := c.privateLeaderboard(year, id)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLeaderboard(body)
+}
+
+func (c *Client) privateLeaderboard(year, id int) ([]byte, error) {
+	body, err := c.Get(fmt.Sprintf("/%d/leaderboard/private/view/%d.json", year, id))
+	if err != nil {
+		return nil, fmt.Errorf("fetching leaderboard: %w", err)
+	}
+	return body, nil
+}
+
+// CachedLeaderboard returns the private leaderboard from the cache if it
+// was fetched less than LeaderboardInterval ago and fetches it otherwise.
+// The returned time is when the leaderboard was fetched.
+func (c *Client) CachedLeaderboard(year, id int, cache *LeaderboardCache) (*Leaderboard, time.Time, error) {
+	session := SessionID(c.Cookie)
+	now := c.currentTime()
+	body, fetched, ok, err := cache.Lookup(year, id, session)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if !ok || now.Sub(fetched) >= LeaderboardInterval {
+		if body, err = c.privateLeaderboard(year, id); err != nil {
+			return nil, time.Time{}, err
+		}
+		fetched = now
+		if err := cache.Store(year, id, session, body, fetched); err != nil {
+			return nil, time.Time{}, fmt.Errorf("caching leaderboard: %w", err)
+		}
+	}
+
+	leaderboard, err := ParseLeaderboard(body)
+	return leaderboard, fetched, err
+}
+
+func ParseLeaderboard(body []byte) (*Leaderboard, error) {
+	leaderboard := &Leaderboard{}
+	if err := json.Unmarshal(body, leaderboard); err != nil {
+		return nil, fmt.Errorf("decoding leaderboard: %w", err)
+	}
+	return leaderboard, nil
+}
+
+// DisplayName returns the name shown on the website
+func (m Member) DisplayName() string {
+	if m.Name == "" {
+		return fmt.Sprintf("(anonymous user #%d)", m.ID)
+	}
+	return m.Name
+}
+
+// StarTime returns when the member got the star of the given day and part
+func (m Member) StarTime(day, part int) (time.Time, bool) {
+	star, ok := m.Completion[day][part]
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(star.GetStarTS, 0), true
+}
+
+// Unlock returns the time the puzzle of the given day was released
+func (l *Leaderboard) Unlock(day int) time.Time {
+	return time.Unix(l.Day1TS, 0).Add(time.Duration(day-1) * 24 * time.Hour)
+}
+
+// Solve returns how long after the unlock the member got the star
+func (l *Leaderboard) Solve(m Member, day, part int) (time.Duration, bool) {
+	t, ok := m.StarTime(day, part)
+	if !ok {
+		return 0, false
+	}
+	return t.Sub(l.Unlock(day)), true
+}
+
+// Days returns the last day any member got a star on
+func (l *Leaderboard) Days() int {
+	days := 0
+	for _, member := range l.Members {
+		for day := range member.Completion {
+			days = max(days, day)
+		}
+	}
+	return days
+}
+
+// Standings ranks the members by their local score counting the days up to
+// and including the given one. Every star is worth the number of members
+// minus the number of members who got it earlier, ties are ranked by who
+// got their last star first.
+func (l *Leaderboard) Standings(day int) []Standing {
+	current := l.standings(day)
+	previous := map[int]int{}
+	for _, standing := range l.standings(day - 1) {
+		previous[standing.Member.ID] = standing.Rank
+	}
+	for index := range current {
+		if day > 1 {
+			current[index].Change = previous[current[index].Member.ID] - current[index].Rank
+		}
+	}
+	return current
+}
+
+func (l *Leaderboard) standings(day int) []Standing {
+	members := []Member{}
+	for _, member := range l.Members {
+		members = append(members, member)
+	}
+
+	scores := map[int]int{}
+	stars := map[int]int{}
+	last := map[int]int64{}
+	for d := 1; d <= day; d++ {
+		for part := 1; part <= 2; part++ {
+			solvers := []Member{}
+			for _, member := range members {
+				if star, ok := member.Completion[d][part]; ok {
+					solvers = append(solvers, member)
+					stars[member.ID]++
+					last[member.ID] = max(last[member.ID], star.GetStarTS)
+				}
+			}
+			slices.SortFunc(solvers, func(a, b Member) int {
+				return cmp.Compare(a.Completion[d][part].GetStarTS, b.Completion[d][part].GetStarTS)
+			})
+			for index, member := range solvers {
+				scores[member.ID] += len(members) - index
+			}
+		}
+	}
+
+	slices.SortFunc(members, func(a, b Member) int {
+		if scores[a.ID] != scores[b.ID] {
+			return scores[b.ID] - scores[a.ID]
+		}
+		if last[a.ID] != last[b.ID] {
+			return cmp.Compare(last[a.ID], last[b.ID])
+		}
+		return a.ID - b.ID
+	})
+
+	standings := []Standing{}
+	for index, member := range members {
+		standings = append(standings, Standing{
+			Member: member,
+			Rank:   index + 1,
+			Score:  scores[member.ID],
+			Stars:  stars[member.ID],
+		})
+	}
+	return standings
+}
+
+// WriteStandings prints the ranking after the given day with the rank
+// changes compared to the day before
+func (l *Leaderboard) WriteStandings(w io.Writer, day int) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "RANK\tCHANGE\tNAME\tSCORE\tSTARS")
+	for _, s := range l.Standings(day) {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%d\n", s.Rank, formatChange(s.Change), s.Member.DisplayName(), s.Score, s.Stars)
+	}
+	return tw.Flush()
+}
+
+// WriteDay prints when every member solved both parts of the given day and
+// how long part 2 took after part 1
+func (l *Leaderboard) WriteDay(w io.Writer, day int) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tPART 1\tPART 2\tDELTA")
+	for _, s := range l.Standings(day) {
+		part1, ok1 := l.Solve(s.Member, day, 1)
+		part2, ok2 := l.Solve(s.Member, day, 2)
+		if !ok1 {
+			continue
+		}
+		row := []string{s.Member.DisplayName(), formatClock(part1), "-", "-"}
+		if ok2 {
+			row[2] = formatClock(part2)
+			row[3] = formatClock(part2 - part1)
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// formatClock formats a duration like the AOC leaderboards, e.g. 01:02:03
+func formatClock(d time.Duration) string {
+	d = d.Round(time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+func formatChange(change int) string {
+	switch {
+	case change > 0:
+		return fmt.Sprintf("+%d", change)
+	case change < 0:
+		return fmt.Sprintf("%d", change)
+	default:
+		return "="
+	}
+}
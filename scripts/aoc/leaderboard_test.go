@@ -0,0 +1,89 @@
+package aoc
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func loadLeaderboard(t *testing.T) *Leaderboard {
+	t.Helper()
+	body, err := os.ReadFile("testdata/leaderboard.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaderboard, err := ParseLeaderboard(body)
+	if err != nil {
+		t.Fatalf("ParseLeaderboard() error = %v", err)
+	}
+	return leaderboard
+}
+
+func TestLeaderboardStandings(t *testing.T) {
+	leaderboard := loadLeaderboard(t)
+
+	if got := leaderboard.Days(); got != 3 {
+		t.Errorf("Days() = %d, want 3", got)
+	}
+
+	tests := []struct {
+		name string
+		day  int
+		want []string // name:rank:score:change
+	}{
+		{"day 1 tie on last star", 1, []string{"alice:1:5:0", "bob:2:5:0", "(anonymous user #3):3:1:0"}},
+		{"day 2", 2, []string{"alice:1:10:0", "bob:2:8:0", "(anonymous user #3):3:1:0"}},
+		{"day 3", 3, []string{"bob:1:14:1", "alice:2:12:-1", "(anonymous user #3):3:1:0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, s := range leaderboard.Standings(tt.day) {
+				got = append(got, strings.Join([]string{s.Member.DisplayName(), strconv.Itoa(s.Rank), strconv.Itoa(s.Score), strconv.Itoa(s.Change)}, ":"))
+			}
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("Standings(%d) = %v, want %v", tt.day, got, tt.want)
+			}
+		})
+	}
+
+	// the final standings agree with the scores computed by AOC
+	for _, s := range leaderboard.Standings(leaderboard.Days()) {
+		if s.Score != s.Member.LocalScore || s.Stars != s.Member.Stars {
+			t.Errorf("%s has score %d and %d stars, AOC reports %d and %d", s.Member.DisplayName(), s.Score, s.Stars, s.Member.LocalScore, s.Member.Stars)
+		}
+	}
+}
+
+func TestLeaderboardWrite(t *testing.T) {
+	leaderboard := loadLeaderboard(t)
+
+	if got, ok := leaderboard.Solve(leaderboard.Members["1"], 2, 2); !ok || got != 5000*time.Second {
+		t.Errorf("Solve() = %v, %v, want 1h23m20s", got, ok)
+	}
+
+	builder := strings.Builder{}
+	if err := leaderboard.WriteStandings(&builder, 3); err != nil {
+		t.Fatal(err)
+	}
+	want := "RANK  CHANGE  NAME                 SCORE  STARS\n" +
+		"1     +1      bob                  14     5\n" +
+		"2     -1      alice                12     5\n" +
+		"3     =       (anonymous user #3)  1      1\n"
+	if builder.String() != want {
+		t.Errorf("WriteStandings() =\n%s\nwant\n%s", builder.String(), want)
+	}
+
+	builder.Reset()
+	if err := leaderboard.WriteDay(&builder, 2); err != nil {
+		t.Fatal(err)
+	}
+	want = "NAME   PART 1    PART 2    DELTA\n" +
+		"alice  00:16:40  01:23:20  01:06:40\n" +
+		"bob    00:13:20  -         -\n"
+	if builder.String() != want {
+		t.Errorf("WriteDay() =\n%s\nwant\n%s", builder.String(), want)
+	}
+}
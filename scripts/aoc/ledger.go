@@ -0,0 +1,154 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Submission is a single answer sent to adventofcode.com
+type Submission struct {
+	Level   int       `json:"level"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// Ledger keeps every submission of one day, it is used to refuse answers
+// that are known to be wrong before they cost another lockout
+type Ledger struct {
+	Submissions []Submission `json:"submissions"`
+}
+
+// ErrRefused is wrapped by the errors of Ledger.Check
+var ErrRefused = errors.New("refusing to submit")
+
+// LedgerName is the name of the ledger file in the directory of a day
+const LedgerName = "submissions.json"
+
+func LedgerFilename(day, year int) string {
+	return DayFilename(day, year, LedgerName)
+}
+
+// LoadLedger reads the ledger file, a missing file is an empty ledger
+func LoadLedger(filename string) (*Ledger, error) {
+	ledger := &Ledger{}
+	content, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return ledger, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading ledger: %w", err)
+	}
+	if err := json.Unmarshal(content, ledger); err != nil {
+		return nil, fmt.Errorf("parsing ledger %s: %w", filename, err)
+	}
+	return ledger, nil
+}
+
+func (l *Ledger) Save(filename string) error {
+	content, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+		return fmt.Errorf("making directory: %w", err)
+	}
+	return os.WriteFile(filename, append(content, '\n'), os.FileMode(0644))
+}
+
+// Record appends the submission with the verdict of the result
+func (l *Ledger) Record(level int, answer string, result SubmitResult) {
+	l.Submissions = append(l.Submissions, Submission{
+		Level:   level,
+		Answer:  strings.TrimSpace(answer),
+		Verdict: result.Verdict,
+		Time:    time.Now().UTC(),
+	})
+}
+
+// Accepted returns the correct answer of the level if it was submitted
+func (l *Ledger) Accepted(level int) (string, bool) {
+	for _, s := range l.Submissions {
+		if s.Level == level && s.Verdict == CORRECT {
+			return s.Answer, true
+		}
+	}
+	return "", false
+}
+
+// Bounds returns the smallest answer that was too high and the largest that
+// was too low, nil if there is none
+func (l *Ledger) Bounds(level int) (low, high *big.Int) {
+	for _, s := range l.Submissions {
+		if s.Level != level {
+			continue
+		}
+		value, ok := new(big.Int).SetString(s.Answer, 10)
+		if !ok {
+			continue
+		}
+		switch s.Verdict {
+		case TOO_HIGH:
+			if high == nil || value.Cmp(high) < 0 {
+				high = value
+			}
+		case TOO_LOW:
+			if low == nil || value.Cmp(low) > 0 {
+				low = value
+			}
+		}
+	}
+	return low, high
+}
+
+// Check returns an error wrapping ErrRefused if the level is already solved,
+// the answer was rejected before or it is outside the known bounds
+func (l *Ledger) Check(level int, answer string) error {
+	answer = strings.TrimSpace(answer)
+	if accepted, ok := l.Accepted(level); ok {
+		return fmt.Errorf("%w: part %d was already solved with %s", ErrRefused, level, accepted)
+	}
+
+	for _, s := range l.Submissions {
+		if s.Level != level || s.Answer != answer {
+			continue
+		}
+		switch s.Verdict {
+		case TOO_HIGH, TOO_LOW, WRONG:
+			return fmt.Errorf("%w: %s was already rejected as %s", ErrRefused, answer, s.Verdict)
+		}
+	}
+
+	value, ok := new(big.Int).SetString(answer, 10)
+	if !ok {
+		return nil
+	}
+	low, high := l.Bounds(level)
+	if high != nil && value.Cmp(high) >= 0 {
+		return fmt.Errorf("%w: %s is not below %s which was too high", ErrRefused, answer, high)
+	}
+	if low != nil && value.Cmp(low) <= 0 {
+		return fmt.Errorf("%w: %s is not above %s which was too low", ErrRefused, answer, low)
+	}
+	return nil
+}
+
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func (v *Verdict) UnmarshalText(text []byte) error {
+	for verdict, name := range verdictNames {
+		if name == string(text) {
+			*v = verdict
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown verdict %q", text)
+}
@@ -0,0 +1,69 @@
+package aoc
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestLedgerCheck(t *testing.T) {
+	ledger := &Ledger{}
+	ledger.Record(1, "500", SubmitResult{Verdict: TOO_HIGH})
+	ledger.Record(1, "100", SubmitResult{Verdict: TOO_LOW})
+	ledger.Record(1, "300", SubmitResult{Verdict: WRONG})
+	ledger.Record(1, "250", SubmitResult{Verdict: WAIT})
+	ledger.Record(1, "abc", SubmitResult{Verdict: WRONG})
+	ledger.Record(2, "42", SubmitResult{Verdict: CORRECT})
+
+	tests := []struct {
+		name    string
+		level   int
+		answer  string
+		refused bool
+	}{
+		{"inside_bounds", 1, "200", false},
+		{"waited_before", 1, "250", false},
+		{"rejected", 1, "300", true},
+		{"too_high", 1, "500", true},
+		{"above_too_high", 1, "12345678901234567890", true},
+		{"too_low", 1, "100", true},
+		{"below_too_low", 1, "-3", true},
+		{"rejected_text", 1, "abc", true},
+		{"other_text", 1, "abd", false},
+		{"solved", 2, "43", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ledger.Check(tt.level, tt.answer)
+			if refused := errors.Is(err, ErrRefused); refused != tt.refused {
+				t.Errorf("Check() error = %v, want refused %v", err, tt.refused)
+			}
+		})
+	}
+}
+
+func TestLedgerSaveLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "day01", "submissions.json")
+
+	ledger, err := LoadLedger(filename)
+	if err != nil || len(ledger.Submissions) != 0 {
+		t.Fatalf("LoadLedger() of missing file = %v, %v, want empty ledger", ledger, err)
+	}
+
+	ledger.Record(1, "7", SubmitResult{Verdict: TOO_LOW})
+	ledger.Record(1, " 9\n", SubmitResult{Verdict: CORRECT})
+	if err := ledger.Save(filename); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := LoadLedger(filename)
+	if err != nil {
+		t.Fatalf("LoadLedger() error = %v", err)
+	}
+	if len(loaded.Submissions) != 2 || loaded.Submissions[0].Verdict != TOO_LOW {
+		t.Errorf("LoadLedger() = %+v, want the two saved submissions", loaded.Submissions)
+	}
+	if answer, ok := loaded.Accepted(1); !ok || answer != "9" {
+		t.Errorf("Accepted() = %q, %v, want 9, true", answer, ok)
+	}
+}
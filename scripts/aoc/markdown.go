@@ -0,0 +1,212 @@
+package aoc
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	spacePattern    = regexp.MustCompile(`[ \t\r\n]+`)
+	markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`")
+)
+
+// toMarkdown converts the children of a day-desc article into markdown
+func toMarkdown(node *html.Node) string {
+	return strings.Join(renderBlocks(node), "\n\n")
+}
+
+var blockElements = map[string]bool{
+	"article": true, "div": true, "main": true, "section": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"p": true, "pre": true, "ul": true, "ol": true, "blockquote": true,
+}
+
+// renderBlocks returns one markdown block per block level child, runs of
+// inline children are combined into a single paragraph
+func renderBlocks(node *html.Node) []string {
+	blocks := []string{}
+	paragraph := strings.Builder{}
+	flush := func() {
+		if text := strings.TrimSpace(paragraph.String()); text != "" {
+			blocks = append(blocks, text)
+		}
+		paragraph.Reset()
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode || !blockElements[child.Data] {
+			paragraph.WriteString(renderInline(child))
+			continue
+		}
+
+		flush()
+		blocks = append(blocks, renderBlock(child)...)
+	}
+	flush()
+	return blocks
+}
+
+// renderBlock converts a single block level element
+func renderBlock(node *html.Node) []string {
+	switch node.Data {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(node.Data[1:])
+		return []string{strings.Repeat("#", level) + " " + strings.TrimSpace(renderInline(node))}
+	case "p":
+		if text := strings.TrimSpace(renderInline(node)); text != "" {
+			return []string{text}
+		}
+		return nil
+	case "pre":
+		code := strings.TrimRight(textContent(node), "\n")
+		return []string{"```\n" + code + "\n```"}
+	case "ul", "ol":
+		return []string{strings.Join(renderList(node), "\n")}
+	case "blockquote":
+		lines := strings.Split(strings.Join(renderBlocks(node), "\n\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return []string{strings.Join(lines, "\n")}
+	default:
+		return renderBlocks(node)
+	}
+}
+
+// renderList returns the lines of the list, nested lists are indented
+func renderList(list *html.Node) []string {
+	lines := []string{}
+	number := 1
+	for item := list.FirstChild; item != nil; item = item.NextSibling {
+		if item.Type != html.ElementNode || item.Data != "li" {
+			continue
+		}
+
+		marker := "- "
+		if list.Data == "ol" {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		indent := strings.Repeat(" ", len(marker))
+
+		text := strings.Builder{}
+		nested := []string{}
+		for child := item.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type == html.ElementNode && (child.Data == "ul" || child.Data == "ol") {
+				nested = append(nested, renderList(child)...)
+			} else if child.Type == html.ElementNode && blockElements[child.Data] {
+				text.WriteString(" " + strings.Join(renderBlock(child), " ") + " ")
+			} else {
+				text.WriteString(renderInline(child))
+			}
+		}
+
+		lines = append(lines, marker+strings.TrimSpace(text.String()))
+		for _, line := range nested {
+			lines = append(lines, indent+line)
+		}
+	}
+	return lines
+}
+
+// renderInline converts text and inline elements, whitespace is collapsed
+// like a browser would
+func renderInline(node *html.Node) string {
+	switch node.Type {
+	case html.TextNode:
+		return markdownEscaper.Replace(spacePattern.ReplaceAllString(node.Data, " "))
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	children := func() string {
+		builder := strings.Builder{}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			builder.WriteString(renderInline(child))
+		}
+		return builder.String()
+	}
+
+	switch node.Data {
+	case "em", "i", "strong", "b":
+		return wrapInline(children(), "*")
+	case "code":
+		code := "`" + spacePattern.ReplaceAllString(textContent(node), " ") + "`"
+		if hasElement(node, "em") {
+			// AOC highlights answers as <code><em>, markdown can't nest this
+			code = "*" + code + "*"
+		}
+		return code
+	case "a":
+		text := children()
+		href := attr(node, "href")
+		if href == "" {
+			return text
+		}
+		return "[" + text + "](" + resolveURL(href) + ")"
+	case "br":
+		return "  \n"
+	case "script", "style":
+		return ""
+	default:
+		return children()
+	}
+}
+
+// wrapInline adds the markers around text, keeping surrounding whitespace
+// outside of them
+func wrapInline(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	start := strings.Index(text, trimmed)
+	return text[:start] + marker + trimmed + marker + text[start+len(trimmed):]
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	builder := strings.Builder{}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		builder.WriteString(textContent(child))
+	}
+	return builder.String()
+}
+
+func hasElement(node *html.Node, name string) bool {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && (child.Data == name || hasElement(child, name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func attr(node *html.Node, key string) string {
+	for _, a := range node.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// resolveURL makes links relative to the AOC site absolute
+func resolveURL(href string) string {
+	if strings.HasPrefix(href, "#") {
+		return href
+	}
+	base, _ := url.Parse(DefaultBaseURL + "/")
+	ref, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return base.ResolveReference(ref).String()
+}
@@ -0,0 +1,69 @@
+package aoc
+
+import "testing"
+
+var puzzlePage = `<!DOCTYPE html>
+<html lang="en-us">
+<head><title>Day 1 - Advent of Code 2024</title></head>
+<body>
+<main>
+<article class="day-desc"><h2>--- Day 1: Historian Hysteria ---</h2><p>The <em>Chief Historian</em> is always present for the big Christmas sleigh launch, but nobody has seen him in months!</p>
+<p>For example:</p>
+<pre><code>3   4
+4   3
+<em>2</em>   5
+</code></pre>
+<p>Within each pair, figure out <em>how far apart</em> the two numbers are:</p>
+<ul>
+<li>The smallest number in the <code>left</code> list is <code>1</code>, and the smallest in the right list is <code>3</code>.
+  <ul><li>Nested <a href="/2024/about">note</a>.</li></ul>
+</li>
+<li>Then pair up the <span title="so on">second-smallest</span> numbers.</li>
+</ul>
+<p>In the example above, this is <code>2 + 1 + 0</code>, a total distance of <code><em>11</em></code>!</p>
+<p>Your actual left and right lists contain many location IDs. <em>What is the total distance between your lists?</em></p>
+</article>
+<p>Your puzzle answer was <code>1234</code>.</p>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2><p>Steps:</p>
+<ol><li>Count <a href="#part2">matches</a></li><li>Multiply x*y and <code>a*b</code></li></ol>
+</article>
+</main>
+</body>
+</html>`
+
+var puzzleMarkdown = "## --- Day 1: Historian Hysteria ---\n" +
+	"\n" +
+	"The *Chief Historian* is always present for the big Christmas sleigh launch, but nobody has seen him in months!\n" +
+	"\n" +
+	"For example:\n" +
+	"\n" +
+	"```\n" +
+	"3   4\n" +
+	"4   3\n" +
+	"2   5\n" +
+	"```\n" +
+	"\n" +
+	"Within each pair, figure out *how far apart* the two numbers are:\n" +
+	"\n" +
+	"- The smallest number in the `left` list is `1`, and the smallest in the right list is `3`.\n" +
+	"  - Nested [note](https://adventofcode.com/2024/about).\n" +
+	"- Then pair up the second-smallest numbers.\n" +
+	"\n" +
+	"In the example above, this is `2 + 1 + 0`, a total distance of *`11`*!\n" +
+	"\n" +
+	"Your actual left and right lists contain many location IDs. *What is the total distance between your lists?*\n" +
+	"\n" +
+	"Your puzzle answer was `1234`.\n" +
+	"\n" +
+	"## --- Part Two ---\n" +
+	"\n" +
+	"Steps:\n" +
+	"\n" +
+	"1. Count [matches](#part2)\n" +
+	"2. Multiply x\\*y and `a*b`\n"
+
+func TestParseHTML(t *testing.T) {
+	if got := parseHTML([]byte(puzzlePage)); got != puzzleMarkdown {
+		t.Errorf("parseHTML() = \n%s\nwant\n%s", got, puzzleMarkdown)
+	}
+}
@@ -0,0 +1,149 @@
+package aoc
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+)
+
+// Profile is a named session, every profile but the default one stores its
+// inputs as input.<name>.txt next to input.txt and has its own ledger
+type Profile struct {
+	Name    string
+	Cookie  string
+	Default bool
+}
+
+// InputName returns the name of the input file of the profile
+func (p Profile) InputName() string {
+	if p.Default || p.Name == "" {
+		return "input.txt"
+	}
+	return fmt.Sprintf("input.%s.txt", p.Name)
+}
+
+// LedgerName returns the name of the submission ledger of the profile, the
+// answers of other accounts tell nothing about the own ones
+func (p Profile) LedgerName() string {
+	if p.Default || p.Name == "" {
+		return LedgerName
+	}
+	return fmt.Sprintf("submissions.%s.json", p.Name)
+}
+
+// Profiles is the config file listing the session cookies of all accounts
+type Profiles struct {
+	Default  string            `json:"default"`
+	Sessions map[string]string `json:"sessions"`
+}
+
+var profileNameRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// DefaultProfilesFilename is located in the user config dir
+func DefaultProfilesFilename() (string, error) {
+	if filename := os.Getenv("AOC_PROFILES"); filename != "" {
+		return filename, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("finding user config dir: %w", err)
+	}
+	return filepath.Join(dir, "advent-of-code", "profiles.json"), nil
+}
+
+// LoadProfiles reads the config file, a missing file has no profiles
+func LoadProfiles(filename string) (*Profiles, error) {
+	profiles := &Profiles{Sessions: map[string]string{}}
+	content, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading profiles: %w", err)
+	}
+	if err := json.Unmarshal(content, profiles); err != nil {
+		return nil, fmt.Errorf("parsing profiles %s: %w", filename, err)
+	}
+	for name := range profiles.Sessions {
+		if !profileNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid profile name %q, use lower case letters, digits, - and _", name)
+		}
+	}
+	if _, ok := profiles.Sessions[profiles.Default]; profiles.Default != "" && !ok {
+		return nil, fmt.Errorf("default profile %q has no session", profiles.Default)
+	}
+	return profiles, nil
+}
+
+// Get returns the profile with the given name, the empty name selects the
+// default profile
+func (p *Profiles) Get(name string) (Profile, error) {
+	if name == "" {
+		name = p.Default
+	}
+	cookie, ok := p.Sessions[name]
+	if !ok || name == "" {
+		return Profile{}, fmt.Errorf("unknown profile %q", name)
+	}
+	return Profile{Name: name, Cookie: cookie, Default: name == p.Default}, nil
+}
+
+// All returns every profile ordered by name with the default one first
+func (p *Profiles) All() []Profile {
+	names := []string{}
+	for name := range p.Sessions {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		switch {
+		case a == p.Default:
+			return -1
+		case b == p.Default:
+			return 1
+		default:
+			return cmp.Compare(a, b)
+		}
+	})
+
+	profiles := []Profile{}
+	for _, name := range names {
+		profile, _ := p.Get(name)
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
+
+// KnownAnswers maps profile names to the accepted answers of their inputs
+type KnownAnswers map[string][]string
+
+// AnswersName is the name of the known answers file in the directory of a day
+const AnswersName = "answers.json"
+
+// LoadKnownAnswers reads the answers file, a missing file knows no answers
+func LoadKnownAnswers(filename string) (KnownAnswers, error) {
+	answers := KnownAnswers{}
+	content, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return answers, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading answers: %w", err)
+	}
+	if err := json.Unmarshal(content, &answers); err != nil {
+		return nil, fmt.Errorf("parsing answers %s: %w", filename, err)
+	}
+	return answers, nil
+}
+
+func (a KnownAnswers) Save(filename string) error {
+	content, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteToFile(filename, append(content, '\n'))
+}
@@ -0,0 +1,80 @@
+package aoc
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadProfiles(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"valid", `{"default": "main", "sessions": {"main": "abc", "alt": "def", "bob": "ghi"}}`, false},
+		{"invalid name", `{"sessions": {"Main Account": "abc"}}`, true},
+		{"missing default", `{"default": "main", "sessions": {"alt": "def"}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(dir, tt.name+".json")
+			os.WriteFile(filename, []byte(tt.content), 0644)
+			if _, err := LoadProfiles(filename); (err != nil) != tt.wantErr {
+				t.Errorf("LoadProfiles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	profiles, err := LoadProfiles(filepath.Join(dir, "missing.json"))
+	if err != nil || len(profiles.Sessions) != 0 {
+		t.Errorf("LoadProfiles() of a missing file = %+v, %v", profiles, err)
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	profiles := &Profiles{Default: "main", Sessions: map[string]string{"main": "abc", "alt": "def", "bob": "ghi"}}
+
+	want := []Profile{
+		{Name: "main", Cookie: "abc", Default: true},
+		{Name: "alt", Cookie: "def"},
+		{Name: "bob", Cookie: "ghi"},
+	}
+	if got := profiles.All(); !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %+v, want %+v", got, want)
+	}
+	if got, err := profiles.Get(""); err != nil || got != want[0] {
+		t.Errorf("Get(\"\") = %+v, %v, want the default profile", got, err)
+	}
+	if _, err := profiles.Get("carol"); err == nil {
+		t.Errorf("Get() of an unknown profile returned no error")
+	}
+
+	names := []string{}
+	for _, profile := range append(want, Profile{Cookie: "from env", Default: true}) {
+		names = append(names, profile.InputName(), profile.LedgerName())
+	}
+	wantNames := []string{
+		"input.txt", "submissions.json",
+		"input.alt.txt", "submissions.alt.json",
+		"input.bob.txt", "submissions.bob.json",
+		"input.txt", "submissions.json",
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("file names = %q, want %q", names, wantNames)
+	}
+}
+
+func TestKnownAnswers(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "2024/day01", AnswersName)
+	answers := KnownAnswers{"main": {"11", "31"}, "alt": {"12"}}
+	if err := answers.Save(filename); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	got, err := LoadKnownAnswers(filename)
+	if err != nil || !reflect.DeepEqual(got, answers) {
+		t.Errorf("LoadKnownAnswers() = %v, %v, want %v", got, err, answers)
+	}
+}
@@ -3,48 +3,88 @@ package aoc
 import (
 	"bytes"
 	"fmt"
-	"path/filepath"
+	"log"
+	"regexp"
 	"strings"
 
 	"golang.org/x/net/html"
-
-	"github.com/zMoooooritz/advent-of-code/util"
 )
 
-func GetPrompt(day, year int, cookie string) {
-	fmt.Printf("fetching for day %d, year %d\n", day, year)
+// Puzzle fetches the HTML page of the given day
+func (c *Client) Puzzle(day, year int) ([]byte, error) {
+	if err := c.checkUnlocked(day, year); err != nil {
+		return nil, err
+	}
+	return c.Get(fmt.Sprintf("/%d/day/%d", year, day))
+}
 
-	// make the request
-	url := fmt.Sprintf("https://adventofcode.com/%d/day/%d", year, day)
-	body := GetWithAOCCookie(url, cookie)
+var titleRegex = regexp.MustCompile(`<h2>--- Day \d+: (.*?) ---</h2>`)
 
-	// parse the dang html
-	prompt := parseHTML(body)
+// ParseTitle returns the title of a puzzle page without the day, e.g.
+// "Historian Hysteria", or the empty string if the page has none
+func ParseTitle(page []byte) string {
+	match := titleRegex.FindSubmatch(page)
+	if match == nil {
+		return ""
+	}
+	return html.UnescapeString(strings.TrimSpace(string(match[1])))
+}
 
-	// write to file
-	filename := filepath.Join(util.Dirname(), "../../", fmt.Sprintf("%d/day%02d/prompt.md", year, day))
-	WriteToFile(filename, []byte(prompt))
+// SavePrompt fetches the puzzle page and writes its description to filename
+func (c *Client) SavePrompt(day, year int, filename string) error {
+	body, err := c.Puzzle(day, year)
+	if err != nil {
+		return fmt.Errorf("fetching prompt: %w", err)
+	}
+	return WritePrompt(filename, body)
+}
+
+// WritePrompt writes the description of a fetched puzzle page to filename
+func WritePrompt(filename string, page []byte) error {
+	return WriteToFile(filename, []byte(parseHTML(page)))
+}
+
+func GetPrompt(day, year int, cookie string) {
+	fmt.Printf("fetching for day %d, year %d\n", day, year)
+
+	filename := DayFilename(day, year, "prompt.md")
+	if err := NewClient(cookie).SavePrompt(day, year, filename); err != nil {
+		log.Fatalf("%s", err)
+	}
 
 	fmt.Println("Wrote prompt to file: ", filename)
 
 	fmt.Println("Done!")
 }
 
-// uses dfsHTML function once to get the class=day-desc html nodes, then parse
-// the text inside of them
+// uses dfsHTML function once to get the class=day-desc html nodes, then
+// converts each of them to markdown followed by the accepted answer of the
+// part, if it was solved already
 func parseHTML(htmlIn []byte) (promptOnly string) {
-	strBuilder := strings.Builder{}
 	node, _ := html.Parse(bytes.NewReader(htmlIn))
 
-	dayDescNodes := dfsHTML(node, cbFindDayDescClass)
-	dayDescNodesMap := map[*html.Node]bool{}
-
-	for _, ddNode := range dayDescNodes {
-		dayDescNodesMap[ddNode.(*html.Node)] = true
-		dfsHTML(ddNode.(*html.Node), cbParseHTMLText(&strBuilder, dayDescNodesMap))
+	parts := []string{}
+	for _, ddNode := range dfsHTML(node, cbFindDayDescClass) {
+		parts = append(parts, toMarkdown(ddNode.(*html.Node)))
+		if answer := answerParagraph(ddNode.(*html.Node)); answer != nil {
+			parts = append(parts, strings.TrimSpace(renderInline(answer)))
+		}
 	}
 
-	return strBuilder.String()
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// answerParagraph returns the "Your puzzle answer was" paragraph following
+// a day-desc article, it sits outside of the article
+func answerParagraph(article *html.Node) *html.Node {
+	next := article.NextSibling
+	for next != nil && next.Type != html.ElementNode {
+		next = next.NextSibling
+	}
+	if next == nil || next.Data != "p" || !strings.HasPrefix(textContent(next), "Your puzzle answer was") {
+		return nil
+	}
+	return next
 }
 
 // function takes in a node and a callback that is run on each node
@@ -70,15 +110,3 @@ func cbFindDayDescClass(node *html.Node) []interface{} {
 
 	return nil
 }
-
-func cbParseHTMLText(builder *strings.Builder, dayDescNodesMap map[*html.Node]bool) func(*html.Node) []interface{} {
-	return func(node *html.Node) []interface{} {
-		if node.Type == html.TextNode {
-			builder.WriteString(node.Data)
-		}
-		if node.Parent != nil && dayDescNodesMap[node.Parent] {
-			builder.WriteString("\n")
-		}
-		return nil
-	}
-}
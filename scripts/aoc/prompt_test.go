@@ -0,0 +1,22 @@
+package aoc
+
+import "testing"
+
+func TestParseTitle(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want string
+	}{
+		{"puzzle page", puzzlePage, "Historian Hysteria"},
+		{"escaped", `<article class="day-desc"><h2>--- Day 7: Bridge &amp; Repair ---</h2>`, "Bridge & Repair"},
+		{"no title", `<article class="day-desc"><p>nothing</p></article>`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseTitle([]byte(tt.page)); got != tt.want {
+				t.Errorf("ParseTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const DefaultMinInterval = 5 * time.Second
+
+// RateLimiter throttles requests across processes by keeping the time of
+// the last request in a state file, guarded by a lock file next to it
+type RateLimiter struct {
+	Filename    string
+	MinInterval time.Duration
+	// Report is called with the duration before sleeping, may be nil
+	Report func(wait time.Duration)
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+type limiterState struct {
+	Last            time.Time `json:"last"`
+	SubmitNotBefore time.Time `json:"submit_not_before"`
+}
+
+// DefaultRateLimiter keeps its state in the user cache dir and reports
+// every sleep on stdout
+func DefaultRateLimiter() *RateLimiter {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return &RateLimiter{
+		Filename:    filepath.Join(dir, "advent-of-code", "ratelimit.json"),
+		MinInterval: DefaultMinInterval,
+		Report: func(wait time.Duration) {
+			fmt.Printf("rate limit: sleeping %s before the next request\n", wait.Round(time.Millisecond))
+		},
+	}
+}
+
+func (l *RateLimiter) currentTime() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
+
+func (l *RateLimiter) doSleep(d time.Duration) {
+	if l.sleep != nil {
+		l.sleep(d)
+		return
+	}
+	time.Sleep(d)
+}
+
+// Pending returns how long the next request would have to wait
+func (l *RateLimiter) Pending(submit bool) (time.Duration, error) {
+	state, err := l.read()
+	if err != nil {
+		return 0, err
+	}
+	return l.pending(state, submit), nil
+}
+
+func (l *RateLimiter) pending(state limiterState, submit bool) time.Duration {
+	next := state.Last.Add(l.MinInterval)
+	if submit && state.SubmitNotBefore.After(next) {
+		next = state.SubmitNotBefore
+	}
+	return max(next.Sub(l.currentTime()), 0)
+}
+
+// Wait blocks until the next request is allowed and records it. Submissions
+// additionally wait for the time the last submission response asked for.
+// The slot is reserved under the lock and slept for without holding it, so
+// other processes queue up behind the reserved time.
+func (l *RateLimiter) Wait(submit bool) error {
+	var wait time.Duration
+	err := l.locked(func(state *limiterState) {
+		wait = l.pending(*state, submit)
+		state.Last = l.currentTime().Add(wait)
+	})
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		if l.Report != nil {
+			l.Report(wait)
+		}
+		l.doSleep(wait)
+	}
+	return nil
+}
+
+// Delay makes the next submission wait at least for d
+func (l *RateLimiter) Delay(d time.Duration) error {
+	return l.locked(func(state *limiterState) {
+		notBefore := l.currentTime().Add(d)
+		if notBefore.After(state.SubmitNotBefore) {
+			state.SubmitNotBefore = notBefore
+		}
+	})
+}
+
+func (l *RateLimiter) read() (limiterState, error) {
+	state := limiterState{}
+	content, err := os.ReadFile(l.Filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("reading rate limit state: %w", err)
+	}
+	if err := json.Unmarshal(content, &state); err != nil {
+		// a broken state file must not block all requests forever
+		return limiterState{}, nil
+	}
+	return state, nil
+}
+
+// locked runs update on the state while holding the lock file
+func (l *RateLimiter) locked(update func(*limiterState)) error {
+	if err := os.MkdirAll(filepath.Dir(l.Filename), os.ModePerm); err != nil {
+		return fmt.Errorf("making directory: %w", err)
+	}
+
+	lockFilename := l.Filename + ".lock"
+	for {
+		lock, err := os.OpenFile(lockFilename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			lock.Close()
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("locking rate limit state: %w", err)
+		}
+		// a crashed process can leave its lock behind
+		if info, err := os.Stat(lockFilename); err == nil && time.Since(info.ModTime()) > time.Minute {
+			os.Remove(lockFilename)
+			continue
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	defer os.Remove(lockFilename)
+
+	state, err := l.read()
+	if err != nil {
+		return err
+	}
+	update(&state)
+
+	content, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(l.Filename, content, os.FileMode(0644)); err != nil {
+		return fmt.Errorf("writing rate limit state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package aoc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeClock advances when the limiter sleeps
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) limiter(filename string) *RateLimiter {
+	return &RateLimiter{
+		Filename:    filename,
+		MinInterval: 5 * time.Second,
+		now:         func() time.Time { return c.now },
+		sleep: func(d time.Duration) {
+			c.sleeps = append(c.sleeps, d)
+			c.now = c.now.Add(d)
+		},
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 12, 1, 5, 0, 0, 0, time.UTC)}
+	filename := filepath.Join(t.TempDir(), "ratelimit.json")
+	// two limiters sharing a file behave like two processes
+	first, second := clock.limiter(filename), clock.limiter(filename)
+
+	var reported []time.Duration
+	second.Report = func(wait time.Duration) {
+		reported = append(reported, wait)
+	}
+
+	first.Wait(false)
+	clock.now = clock.now.Add(2 * time.Second)
+	second.Wait(false)
+
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != 3*time.Second {
+		t.Errorf("sleeps = %v, want [3s]", clock.sleeps)
+	}
+	if len(reported) != 1 || reported[0] != 3*time.Second {
+		t.Errorf("reported = %v, want [3s]", reported)
+	}
+
+	// a submission asked to wait a minute, other requests are not affected
+	first.Delay(time.Minute)
+	if pending, _ := second.Pending(false); pending != 5*time.Second {
+		t.Errorf("Pending(false) = %v, want 5s", pending)
+	}
+	if pending, _ := second.Pending(true); pending != time.Minute {
+		t.Errorf("Pending(true) = %v, want 1m", pending)
+	}
+
+	clock.now = clock.now.Add(10 * time.Second)
+	second.Wait(true)
+	if got := clock.sleeps[len(clock.sleeps)-1]; got != 50*time.Second {
+		t.Errorf("submission slept %v, want 50s", got)
+	}
+}
+
+func TestRateLimiterSleepsUnlocked(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 12, 1, 5, 0, 0, 0, time.UTC)}
+	filename := filepath.Join(t.TempDir(), "ratelimit.json")
+	first, second := clock.limiter(filename), clock.limiter(filename)
+
+	first.Wait(false)
+	var pending time.Duration
+	second.sleep = func(d time.Duration) {
+		if _, err := os.Stat(filename + ".lock"); err == nil {
+			t.Errorf("lock is held while sleeping")
+		}
+		// the slot of the sleeping request is already taken
+		pending, _ = first.Pending(false)
+		clock.now = clock.now.Add(d)
+	}
+	if err := second.Wait(false); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if pending != 10*time.Second {
+		t.Errorf("Pending() while sleeping = %v, want 10s", pending)
+	}
+}
+
+func TestClientHonoursSubmitWait(t *testing.T) {
+	server := newTestServer(t)
+	server.Cooldown = true
+
+	clock := &fakeClock{now: time.Now()}
+	client := newTestClient(server, "secret")
+	client.Limiter = clock.limiter(filepath.Join(t.TempDir(), "ratelimit.json"))
+	ledger := &Ledger{}
+
+	client.Submit(1, 2024, 1, "20", ledger) // too high, wait one minute
+	client.Submit(1, 2024, 1, "11", ledger) // answered too recently, wait 34s
+	client.Submit(1, 2024, 1, "11", ledger)
+
+	want := []time.Duration{time.Minute, 34 * time.Second}
+	if len(clock.sleeps) != len(want) {
+		t.Fatalf("sleeps = %v, want %v", clock.sleeps, want)
+	}
+	for i := range want {
+		if clock.sleeps[i] != want[i] {
+			t.Errorf("sleeps = %v, want %v", clock.sleeps, want)
+			break
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package aoc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// PartStats is one part of a row of the personal stats page
+type PartStats struct {
+	Done    bool
+	Over24h bool
+	Time    time.Duration
+	Rank    int
+	Score   int
+}
+
+// DayStats is a row of the personal stats page
+type DayStats struct {
+	Day   int
+	Parts [2]PartStats
+}
+
+var statsRowRegex = regexp.MustCompile(`^\s*(\d+)\s+(\S+)\s+(\S+)\s+(\S+)(?:\s+(\S+)\s+(\S+)\s+(\S+))?\s*$`)
+
+// PersonalStats fetches the personal leaderboard times of the given year
+func (c *Client) PersonalStats(year int) ([]DayStats, error) {
+	body, err := c.Get(fmt.Sprintf("/%d/leaderboard/self", year))
+	if err != nil {
+		return nil, fmt.Errorf("fetching personal stats: %w", err)
+	}
+	return ParsePersonalStats(body)
+}
+
+// ParsePersonalStats reads the table of the personal stats page, the days
+// are in the order of the page, i.e. the latest day first
+func ParsePersonalStats(page []byte) ([]DayStats, error) {
+	node, err := html.Parse(bytes.NewReader(page))
+	if err != nil {
+		return nil, fmt.Errorf("parsing personal stats: %w", err)
+	}
+
+	var pre *html.Node
+	dfsHTML(node, func(n *html.Node) []interface{} {
+		if pre == nil && n.Type == html.ElementNode && n.Data == "pre" {
+			pre = n
+		}
+		return nil
+	})
+	if pre == nil {
+		return nil, fmt.Errorf("no stats table found, check the session cookie")
+	}
+
+	stats := []DayStats{}
+	for _, line := range strings.Split(textContent(pre), "\n") {
+		match := statsRowRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		day, _ := strconv.Atoi(match[1])
+		row := DayStats{Day: day}
+		for part := range 2 {
+			fields := match[2+3*part : 5+3*part]
+			if fields[0] == "" {
+				continue
+			}
+			parsed, err := parsePartStats(fields)
+			if err != nil {
+				return nil, fmt.Errorf("day %d part %d: %w", day, part+1, err)
+			}
+			row.Parts[part] = parsed
+		}
+		stats = append(stats, row)
+	}
+	return stats, nil
+}
+
+func parsePartStats(fields []string) (PartStats, error) {
+	if fields[0] == "-" {
+		return PartStats{}, nil
+	}
+
+	stats := PartStats{Done: true}
+	if fields[0] == ">24h" {
+		stats.Over24h = true
+	} else {
+		var h, m, s int
+		if _, err := fmt.Sscanf(fields[0], "%d:%d:%d", &h, &m, &s); err != nil {
+			return stats, fmt.Errorf("invalid time %q", fields[0])
+		}
+		stats.Time = time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+	}
+
+	var err error
+	if stats.Rank, err = strconv.Atoi(fields[1]); err != nil {
+		return stats, fmt.Errorf("invalid rank %q", fields[1])
+	}
+	if stats.Score, err = strconv.Atoi(fields[2]); err != nil {
+		return stats, fmt.Errorf("invalid score %q", fields[2])
+	}
+	return stats, nil
+}
+
+func (p PartStats) clock() string {
+	switch {
+	case !p.Done:
+		return "-"
+	case p.Over24h:
+		return ">24h"
+	default:
+		return formatClock(p.Time)
+	}
+}
+
+// WriteStats prints the personal stats with the time part 2 took after
+// part 1, which is unknown for days that took longer than 24h
+func WriteStats(w io.Writer, stats []DayStats) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART 1\tRANK\tPART 2\tRANK\tDELTA")
+	for _, row := range stats {
+		part1, part2 := row.Parts[0], row.Parts[1]
+		delta := "-"
+		if part1.Done && part2.Done && !part1.Over24h && !part2.Over24h {
+			delta = formatClock(part2.Time - part1.Time)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", row.Day, part1.clock(), formatRank(part1), part2.clock(), formatRank(part2), delta)
+	}
+	return tw.Flush()
+}
+
+func formatRank(p PartStats) string {
+	if !p.Done {
+		return "-"
+	}
+	return strconv.Itoa(p.Rank)
+}
@@ -0,0 +1,61 @@
+package aoc
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+var statsPage = `<!DOCTYPE html>
+<html lang="en-us">
+<body>
+<main>
+<article><p>These are your personal leaderboard statistics.  <em>Rank</em> is your position on that leaderboard: 1st place corresponds to rank 1 and so on.</p>
+<pre><span class="leaderboard-daydesc-first">      --------Part 1---------   </span><span class="leaderboard-daydesc-both">--------Part 2---------</span>
+Day       <span class="leaderboard-daydesc-first">Time    Rank  Score</span>       <span class="leaderboard-daydesc-both">Time    Rank  Score</span>
+  3   00:33:20    2515      0   00:47:12    1872      0
+  2   00:14:56    4093      0       &gt;24h   50000      0
+  1   00:05:10    1001      0          -       -      -
+</pre>
+</article>
+</main>
+</body>
+</html>
+`
+
+func TestParsePersonalStats(t *testing.T) {
+	stats, err := ParsePersonalStats([]byte(statsPage))
+	if err != nil {
+		t.Fatalf("ParsePersonalStats() error = %v", err)
+	}
+
+	want := []DayStats{
+		{3, [2]PartStats{{Done: true, Time: 33*time.Minute + 20*time.Second, Rank: 2515}, {Done: true, Time: 47*time.Minute + 12*time.Second, Rank: 1872}}},
+		{2, [2]PartStats{{Done: true, Time: 14*time.Minute + 56*time.Second, Rank: 4093}, {Done: true, Over24h: true, Rank: 50000}}},
+		{1, [2]PartStats{{Done: true, Time: 5*time.Minute + 10*time.Second, Rank: 1001}, {}}},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("ParsePersonalStats() = %+v, want %+v", stats, want)
+	}
+	for index := range want {
+		if stats[index] != want[index] {
+			t.Errorf("row %d = %+v, want %+v", index, stats[index], want[index])
+		}
+	}
+
+	builder := strings.Builder{}
+	if err := WriteStats(&builder, stats); err != nil {
+		t.Fatal(err)
+	}
+	wantTable := "DAY  PART 1    RANK  PART 2    RANK   DELTA\n" +
+		"3    00:33:20  2515  00:47:12  1872   00:13:52\n" +
+		"2    00:14:56  4093  >24h      50000  -\n" +
+		"1    00:05:10  1001  -         -      -\n"
+	if builder.String() != wantTable {
+		t.Errorf("WriteStats() =\n%s\nwant\n%s", builder.String(), wantTable)
+	}
+
+	if _, err := ParsePersonalStats([]byte("<html><body>log in</body></html>")); err == nil {
+		t.Errorf("ParsePersonalStats() without a table returned no error")
+	}
+}
@@ -0,0 +1,185 @@
+package aoc
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+type Verdict int
+
+const (
+	UNKNOWN Verdict = iota
+	CORRECT
+	TOO_HIGH
+	TOO_LOW
+	WRONG
+	WAIT
+	ALREADY_SOLVED
+)
+
+var verdictNames = map[Verdict]string{
+	UNKNOWN:        "unknown",
+	CORRECT:        "correct",
+	TOO_HIGH:       "too high",
+	TOO_LOW:        "too low",
+	WRONG:          "wrong",
+	WAIT:           "wait",
+	ALREADY_SOLVED: "already solved",
+}
+
+func (v Verdict) String() string {
+	return verdictNames[v]
+}
+
+// SubmitResult is the classified response to a submitted answer, Wait is
+// set if the response asks to wait before the next submission
+type SubmitResult struct {
+	Verdict Verdict
+	Wait    time.Duration
+	Message string
+}
+
+func (r SubmitResult) String() string {
+	if r.Wait > 0 {
+		return fmt.Sprintf("%s (wait %s)", r.Verdict, r.Wait)
+	}
+	return r.Verdict.String()
+}
+
+// Submit posts the answer for the given level (part) and classifies the
+// response. Answers the ledger already knows to be wrong are refused with an
+// error wrapping ErrRefused without contacting the server, the verdict of
+// every submitted answer is recorded in the ledger.
+func (c *Client) Submit(day, year, level int, answer string, ledger *Ledger) (SubmitResult, error) {
+	if err := c.checkUnlocked(day, year); err != nil {
+		return SubmitResult{}, err
+	}
+	if err := ledger.Check(level, answer); err != nil {
+		return SubmitResult{}, err
+	}
+
+	form := url.Values{
+		"level":  {strconv.Itoa(level)},
+		"answer": {answer},
+	}
+	body, err := c.Post(fmt.Sprintf("/%d/day/%d/answer", year, day), form)
+	if err != nil {
+		return SubmitResult{}, fmt.Errorf("submitting answer: %w", err)
+	}
+
+	result := ParseSubmitResponse(body)
+	ledger.Record(level, answer, result)
+	if result.Wait > 0 && c.Limiter != nil {
+		if err := c.Limiter.Delay(result.Wait); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// Submit submits the answer and keeps the day's ledger file of the profile
+// up to date
+func Submit(day, year, level int, answer string, profile Profile) SubmitResult {
+	ledgerFilename := DayFilename(day, year, profile.LedgerName())
+	ledger, err := LoadLedger(ledgerFilename)
+	if err != nil {
+		log.Fatalf("loading ledger: %s", err)
+	}
+
+	fmt.Printf("submitting %q for day %d, year %d, part %d\n", answer, day, year, level)
+
+	// a recorded verdict is saved even if storing the requested wait failed
+	recorded := len(ledger.Submissions)
+	result, err := NewClient(profile.Cookie).Submit(day, year, level, answer, ledger)
+	if len(ledger.Submissions) > recorded {
+		if err := ledger.Save(ledgerFilename); err != nil {
+			log.Fatalf("saving ledger: %s", err)
+		}
+		fmt.Println("Recorded in ledger: ", ledgerFilename)
+	}
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	if result.Verdict == UNKNOWN {
+		log.Printf("unrecognized response: %s", result.Message)
+	}
+
+	return result
+}
+
+var (
+	waitLeftPattern   = regexp.MustCompile(`You have (?:(\d+)m ?)?(?:(\d+)s)? left to wait`)
+	waitMinsPattern   = regexp.MustCompile(`(?i)wait (one|\d+) minutes? before trying again`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// ParseSubmitResponse classifies the HTML returned after submitting an answer
+func ParseSubmitResponse(body []byte) SubmitResult {
+	message := articleText(body)
+	result := SubmitResult{Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		result.Verdict = CORRECT
+	case strings.Contains(message, "You gave an answer too recently"):
+		result.Verdict = WAIT
+	case strings.Contains(message, "Did you already complete it"):
+		result.Verdict = ALREADY_SOLVED
+	case strings.Contains(message, "your answer is too high"):
+		result.Verdict = TOO_HIGH
+	case strings.Contains(message, "your answer is too low"):
+		result.Verdict = TOO_LOW
+	case strings.Contains(message, "That's not the right answer"):
+		result.Verdict = WRONG
+	}
+
+	if match := waitLeftPattern.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		result.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := waitMinsPattern.FindStringSubmatch(message); match != nil {
+		minutes := 1
+		if match[1] != "one" {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+		result.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return result
+}
+
+// articleText returns the whitespace normalized text of the <article> node
+// holding the response message, or of the whole document if there is none
+func articleText(body []byte) string {
+	node, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return string(body)
+	}
+
+	articles := dfsHTML(node, func(n *html.Node) []interface{} {
+		if n.Type == html.ElementNode && n.Data == "article" {
+			return []interface{}{n}
+		}
+		return nil
+	})
+	if len(articles) > 0 {
+		node = articles[0].(*html.Node)
+	}
+
+	builder := strings.Builder{}
+	dfsHTML(node, func(n *html.Node) []interface{} {
+		if n.Type == html.TextNode {
+			builder.WriteString(n.Data)
+		}
+		return nil
+	})
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(builder.String(), " "))
+}
@@ -0,0 +1,77 @@
+package aoc
+
+import (
+	"testing"
+	"time"
+)
+
+func response(message string) []byte {
+	return []byte(`<!DOCTYPE html><html><head><title>Day 1 - Advent of Code 2024</title></head><body>
+<header><h1 class="title-global"><a href="/">Advent of Code</a></h1></header>
+<main>
+<article><p>` + message + `</p></article>
+</main></body></html>`)
+}
+
+func TestParseSubmitResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		want     Verdict
+		wantWait time.Duration
+	}{
+		{
+			name:    "correct",
+			message: `That's the right answer!  You are <span class="day-success">one gold star</span> closer to finding the Chief Historian. <a href="/2024/day/1#part2">[Continue to Part Two]</a>`,
+			want:    CORRECT,
+		},
+		{
+			name:     "too_high",
+			message:  `That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2024/day/1">[Return to Day 1]</a>`,
+			want:     TOO_HIGH,
+			wantWait: time.Minute,
+		},
+		{
+			name:     "too_low",
+			message:  `That's not the right answer; your answer is too low.  Because you have guessed incorrectly 4 times on this puzzle, please wait 5 minutes before trying again. <a href="/2024/day/1">[Return to Day 1]</a>`,
+			want:     TOO_LOW,
+			wantWait: 5 * time.Minute,
+		},
+		{
+			name:     "wrong",
+			message:  `That's not the right answer.  If you're stuck, make sure you're using the full input data; there are also some general tips on the <a href="/2024/about">about page</a>. Please wait one minute before trying again.`,
+			want:     WRONG,
+			wantWait: time.Minute,
+		},
+		{
+			name:     "wait",
+			message:  `You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait. <a href="/2024/day/1">[Return to Day 1]</a>`,
+			want:     WAIT,
+			wantWait: 34 * time.Second,
+		},
+		{
+			name:     "wait_minutes",
+			message:  `You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 2s left to wait.`,
+			want:     WAIT,
+			wantWait: 4*time.Minute + 2*time.Second,
+		},
+		{
+			name:    "already_solved",
+			message: `You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/1">[Return to Day 1]</a>`,
+			want:    ALREADY_SOLVED,
+		},
+		{
+			name:    "unknown",
+			message: `Something else entirely`,
+			want:    UNKNOWN,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseSubmitResponse(response(tt.message))
+			if got.Verdict != tt.want || got.Wait != tt.wantWait {
+				t.Errorf("ParseSubmitResponse() = %v, %v, want %v, %v", got.Verdict, got.Wait, tt.want, tt.wantWait)
+			}
+		})
+	}
+}
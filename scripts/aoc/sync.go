@@ -0,0 +1,60 @@
+package aoc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var answerRegex = regexp.MustCompile(`Your puzzle answer was <code>([^<]*)</code>`)
+
+// SyncResult is the state of a puzzle page at the time of a Sync
+type SyncResult struct {
+	Parts    int      // number of unlocked parts
+	Answers  []string // accepted answers of the solved parts
+	Examples []PartExamples
+	Updated  bool // whether the prompt file changed
+}
+
+// ParseAnswers returns the accepted answers shown on the puzzle page, the
+// first one belongs to part 1
+func ParseAnswers(page []byte) []string {
+	answers := []string{}
+	for _, match := range answerRegex.FindAllSubmatch(page, -1) {
+		answers = append(answers, html.UnescapeString(strings.TrimSpace(string(match[1]))))
+	}
+	return answers
+}
+
+// Sync refetches the puzzle page and rewrites the prompt file if a new part
+// or answer appeared since it was written
+func (c *Client) Sync(day, year int, promptFilename string) (SyncResult, error) {
+	page, err := c.Puzzle(day, year)
+	if err != nil {
+		return SyncResult{}, fmt.Errorf("fetching prompt: %w", err)
+	}
+
+	result := SyncResult{
+		Answers:  ParseAnswers(page),
+		Examples: ParseExamples(page),
+	}
+	result.Parts = len(result.Examples)
+
+	prompt := []byte(parseHTML(page))
+	existing, err := os.ReadFile(promptFilename)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return result, fmt.Errorf("reading prompt: %w", err)
+	}
+	if !bytes.Equal(existing, prompt) {
+		if err := WriteToFile(promptFilename, prompt); err != nil {
+			return result, err
+		}
+		result.Updated = true
+	}
+	return result, nil
+}
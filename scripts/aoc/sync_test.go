@@ -0,0 +1,48 @@
+package aoc
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestParseAnswers(t *testing.T) {
+	if got := ParseAnswers([]byte(puzzlePage)); !slices.Equal(got, []string{"1234"}) {
+		t.Errorf("ParseAnswers() = %q, want [1234]", got)
+	}
+}
+
+func TestClientSync(t *testing.T) {
+	server := newTestServer(t)
+	client := newTestClient(server, "secret")
+	filename := filepath.Join(t.TempDir(), "prompt.md")
+
+	steps := []struct {
+		name    string
+		submit  string
+		parts   int
+		answers []string
+		updated bool
+	}{
+		{"first", "", 1, []string{}, true},
+		{"unchanged", "", 1, []string{}, false},
+		{"part one solved", "11", 2, []string{"11"}, true},
+		{"part two solved", "31", 2, []string{"11", "31"}, true},
+		{"unchanged after solving", "", 2, []string{"11", "31"}, false},
+	}
+	for _, step := range steps {
+		if step.submit != "" {
+			level := len(step.answers)
+			if _, err := client.Submit(1, 2024, level, step.submit, &Ledger{}); err != nil {
+				t.Fatalf("%s: Submit() error = %v", step.name, err)
+			}
+		}
+		got, err := client.Sync(1, 2024, filename)
+		if err != nil {
+			t.Fatalf("%s: Sync() error = %v", step.name, err)
+		}
+		if got.Parts != step.parts || !slices.Equal(got.Answers, step.answers) || got.Updated != step.updated {
+			t.Errorf("%s: Sync() = %+v, want %d parts, answers %q, updated %v", step.name, got, step.parts, step.answers, step.updated)
+		}
+	}
+}
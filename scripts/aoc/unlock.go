@@ -0,0 +1,93 @@
+package aoc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Eastern is the time zone the puzzles unlock in, midnight EST. December
+// never observes daylight saving time, so the fixed zone is only a fallback
+// for systems without the time zone database.
+var Eastern = loadEastern()
+
+func loadEastern() *time.Location {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.FixedZone("EST", -5*60*60)
+	}
+	return location
+}
+
+// eventDays lists the events whose number of puzzles differs from the 25 of
+// the first events, later events have as many puzzles as the last one listed
+var eventDays = map[int]int{
+	2025: 12,
+}
+
+// DaysIn returns the number of puzzles of the event of the given year
+func DaysIn(year int) int {
+	days, since := 25, 0
+	for y, d := range eventDays {
+		if y <= year && y > since {
+			days, since = d, y
+		}
+	}
+	return days
+}
+
+// UnlockTime returns when the puzzle of the given day becomes available
+func UnlockTime(day, year int) time.Time {
+	return time.Date(year, time.December, day, 0, 0, 0, 0, Eastern)
+}
+
+// IsUnlocked reports whether the puzzle is available at the given time
+func IsUnlocked(day, year int, now time.Time) bool {
+	return !now.Before(UnlockTime(day, year))
+}
+
+// CurrentPuzzle returns the latest puzzle unlocked at the given time, i.e.
+// today's puzzle in December up to the last day of the event and the last
+// puzzle of the latest event otherwise
+func CurrentPuzzle(now time.Time) (day, year int) {
+	now = now.In(Eastern)
+	switch {
+	case now.Month() != time.December:
+		return DaysIn(now.Year() - 1), now.Year() - 1
+	case now.Day() > DaysIn(now.Year()):
+		return DaysIn(now.Year()), now.Year()
+	default:
+		return now.Day(), now.Year()
+	}
+}
+
+// NextPuzzle returns the puzzle that unlocks next after the given time
+func NextPuzzle(now time.Time) (day, year int) {
+	now = now.In(Eastern)
+	if now.Month() == time.December && now.Day() < DaysIn(now.Year()) {
+		return now.Day() + 1, now.Year()
+	}
+	if now.Month() == time.December {
+		return 1, now.Year() + 1
+	}
+	return 1, now.Year()
+}
+
+func (c *Client) currentTime() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// checkUnlocked refuses requests for puzzles that are not released yet or
+// don't exist instead of sending them to the server
+func (c *Client) checkUnlocked(day, year int) error {
+	if day < 1 || day > DaysIn(year) {
+		return fmt.Errorf("%w: the %d event has %d puzzles", ErrNoPuzzle, year, DaysIn(year))
+	}
+	if now := c.currentTime(); !IsUnlocked(day, year, now) {
+		unlock := UnlockTime(day, year)
+		return fmt.Errorf("%w: day %d of %d unlocks at %s (in %s)", ErrLocked, day, year, unlock.Local().Format(time.DateTime), unlock.Sub(now).Round(time.Second))
+	}
+	return nil
+}
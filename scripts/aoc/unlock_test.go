@@ -0,0 +1,100 @@
+package aoc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUnlockTime(t *testing.T) {
+	want := time.Date(2024, time.December, 1, 5, 0, 0, 0, time.UTC)
+	if got := UnlockTime(1, 2024); !got.Equal(want) {
+		t.Errorf("UnlockTime() = %v, want %v", got, want)
+	}
+	if IsUnlocked(1, 2024, want.Add(-time.Second)) || !IsUnlocked(1, 2024, want) {
+		t.Errorf("IsUnlocked() is wrong around %v", want)
+	}
+}
+
+func TestCurrentPuzzle(t *testing.T) {
+	tests := []struct {
+		name     string
+		now      time.Time
+		wantDay  int
+		wantYear int
+		nextDay  int
+		nextYear int
+	}{
+		{"before unlock in UTC", time.Date(2024, time.December, 5, 4, 59, 0, 0, time.UTC), 4, 2024, 5, 2024},
+		{"after unlock in UTC", time.Date(2024, time.December, 5, 5, 0, 0, 0, time.UTC), 5, 2024, 6, 2024},
+		{"new year in Berlin", time.Date(2025, time.January, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)), 25, 2024, 1, 2025},
+		{"after the event", time.Date(2024, time.December, 27, 12, 0, 0, 0, time.UTC), 25, 2024, 1, 2025},
+		{"day 25", time.Date(2024, time.December, 25, 12, 0, 0, 0, time.UTC), 25, 2024, 1, 2025},
+		{"november", time.Date(2024, time.November, 30, 12, 0, 0, 0, time.UTC), 25, 2023, 1, 2024},
+		{"shorter event", time.Date(2025, time.December, 11, 12, 0, 0, 0, time.UTC), 11, 2025, 12, 2025},
+		{"after the shorter event", time.Date(2025, time.December, 20, 12, 0, 0, 0, time.UTC), 12, 2025, 1, 2026},
+		{"year after the shorter event", time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC), 12, 2025, 1, 2026},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if day, year := CurrentPuzzle(tt.now); day != tt.wantDay || year != tt.wantYear {
+				t.Errorf("CurrentPuzzle() = %d, %d, want %d, %d", day, year, tt.wantDay, tt.wantYear)
+			}
+			if day, year := NextPuzzle(tt.now); day != tt.nextDay || year != tt.nextYear {
+				t.Errorf("NextPuzzle() = %d, %d, want %d, %d", day, year, tt.nextDay, tt.nextYear)
+			}
+		})
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	tests := []struct {
+		year int
+		want int
+	}{
+		{2015, 25},
+		{2024, 25},
+		{2025, 12},
+		{2026, 12},
+	}
+	for _, tt := range tests {
+		if got := DaysIn(tt.year); got != tt.want {
+			t.Errorf("DaysIn(%d) = %d, want %d", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestClientRefusesLockedPuzzles(t *testing.T) {
+	server := newTestServer(t)
+	client := newTestClient(server, "secret")
+	client.now = func() time.Time { return time.Date(2024, time.November, 30, 23, 59, 0, 0, Eastern) }
+
+	if _, err := client.Puzzle(1, 2024); !errors.Is(err, ErrLocked) {
+		t.Errorf("Puzzle() error = %v, want %v", err, ErrLocked)
+	}
+	if _, err := client.Input(1, 2024); !errors.Is(err, ErrLocked) {
+		t.Errorf("Input() error = %v, want %v", err, ErrLocked)
+	}
+	if _, err := client.Submit(1, 2024, 1, "11", &Ledger{}); !errors.Is(err, ErrLocked) {
+		t.Errorf("Submit() error = %v, want %v", err, ErrLocked)
+	}
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Errorf("server received %+v", requests)
+	}
+}
+
+func TestClientRefusesMissingPuzzles(t *testing.T) {
+	server := newTestServer(t)
+	client := newTestClient(server, "secret")
+	client.now = func() time.Time { return time.Date(2026, time.January, 1, 12, 0, 0, 0, Eastern) }
+
+	if _, err := client.Puzzle(13, 2025); !errors.Is(err, ErrNoPuzzle) {
+		t.Errorf("Puzzle() error = %v, want %v", err, ErrNoPuzzle)
+	}
+	if _, err := client.Input(25, 2025); !errors.Is(err, ErrNoPuzzle) {
+		t.Errorf("Input() error = %v, want %v", err, ErrNoPuzzle)
+	}
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Errorf("server received %+v", requests)
+	}
+}
@@ -1,8 +1,13 @@
 package main
 
-import "github.com/zMoooooritz/advent-of-code/scripts/aoc"
+import (
+	"flag"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
 
 func main() {
-	day, year, cookie := aoc.ParseFlags()
-	aoc.GetInput(day, year, cookie)
+	force := flag.Bool("force", false, "refetch the input and overwrite a differing input file")
+	day, year, profile := aoc.ParseProfileFlags()
+	aoc.GetInput(day, year, profile, *force)
 }
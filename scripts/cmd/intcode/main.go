@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/zMoooooritz/advent-of-code/intcode"
+)
+
+func main() {
+	file := flag.String("file", "input.txt", "intcode program to run")
+	flag.Parse()
+
+	program, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("reading program: %s", err)
+	}
+
+	cpu := intcode.NewCPU(intcode.ParseProgram(strings.TrimSpace(string(program))))
+	err = intcode.Interactive(intcode.NewASCII(cpu), os.Stdin, os.Stdout)
+	if err != nil {
+		log.Fatalf("reading command: %s", err)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+func main() {
+	defaultID, _ := strconv.Atoi(os.Getenv("AOC_LEADERBOARD_ID"))
+	id := flag.Int("id", defaultID, "private leaderboard id, defaults to env var AOC_LEADERBOARD_ID")
+	self := flag.Bool("self", false, "show the personal stats instead of the private leaderboard")
+	day, year, cookie := aoc.ParseFlags()
+	client := aoc.NewClient(cookie)
+
+	if *self {
+		stats, err := client.PersonalStats(year)
+		if err != nil {
+			log.Fatalf("%s", err)
+		}
+		aoc.WriteStats(os.Stdout, stats)
+		return
+	}
+
+	if *id == 0 {
+		log.Fatalf("no leaderboard id set on flag or env var (AOC_LEADERBOARD_ID)")
+	}
+	cache, err := aoc.DefaultLeaderboardCache()
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	leaderboard, fetched, err := client.CachedLeaderboard(year, *id, cache)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	fmt.Printf("Leaderboard fetched %s\n\n", fetched.Local().Format(time.DateTime))
+
+	day = min(day, leaderboard.Days())
+	if day == 0 {
+		fmt.Println("no stars yet")
+		return
+	}
+	fmt.Printf("Standings after day %d\n\n", day)
+	leaderboard.WriteStandings(os.Stdout, day)
+	fmt.Printf("\nDay %d\n\n", day)
+	leaderboard.WriteDay(os.Stdout, day)
+}
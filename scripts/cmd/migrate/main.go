@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/migrate"
+	"github.com/zMoooooritz/advent-of-code/scripts/runner"
+	"github.com/zMoooooritz/advent-of-code/scripts/skeleton"
+	"github.com/zMoooooritz/advent-of-code/util"
+)
+
+func main() {
+	root := filepath.Join(util.Dirname(), "../../..")
+	solutions, err := runner.DayDirs(root)
+	if err != nil {
+		log.Fatalf("finding solutions: %s", err)
+	}
+
+	migrated := 0
+	for _, s := range solutions {
+		changed, err := migrate.Day(s.Dir, s.Year, s.Day)
+		if err != nil {
+			log.Fatalf("%s: %s", s, err)
+		}
+		loads, err := migrate.Inputs(s.Dir)
+		if err != nil {
+			log.Fatalf("%s: %s", s, err)
+		}
+		if changed || loads {
+			fmt.Println("migrated", s)
+			migrated++
+		}
+	}
+
+	if err := skeleton.WriteDays(root); err != nil {
+		log.Fatalf("writing %s: %s", runner.DaysFilename, err)
+	}
+	fmt.Printf("%d of %d days migrated, imports written to %s\n", migrated, len(solutions), runner.DaysFilename)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+	"github.com/zMoooooritz/advent-of-code/scripts/runner"
+	"github.com/zMoooooritz/advent-of-code/scripts/skeleton"
+	"github.com/zMoooooritz/advent-of-code/util"
+)
+
+func main() {
+	fetch := flag.Bool("fetch", true, "fetch the inputs and answers of every profile before generating the test")
+	all := flag.Bool("all", false, "regenerate the test of every day from the stored answers without fetching")
+	// every profile uses its own session, so none is resolved from the flags
+	day, year := aoc.ParseDayFlags()
+
+	filename, err := aoc.DefaultProfilesFilename()
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	profiles, err := aoc.LoadProfiles(filename)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	if len(profiles.Sessions) == 0 {
+		log.Fatalf("no profiles in %s", filename)
+	}
+
+	if *all {
+		solutions, err := runner.DayDirs(filepath.Join(util.Dirname(), "../../.."))
+		if err != nil {
+			log.Fatalf("finding solutions: %s", err)
+		}
+		for _, s := range solutions {
+			generate(s.Dir, profiles)
+		}
+		return
+	}
+
+	if *fetch {
+		fetchAll(day, year, profiles)
+	}
+	generate(filepath.Dir(aoc.DayFilename(day, year, aoc.AnswersName)), profiles)
+}
+
+// fetchAll stores the input and the accepted answers of every profile
+func fetchAll(day, year int, profiles *aoc.Profiles) {
+	cache, err := aoc.DefaultInputCache()
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	answersFilename := aoc.DayFilename(day, year, aoc.AnswersName)
+	answers, err := aoc.LoadKnownAnswers(answersFilename)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	for _, profile := range profiles.All() {
+		client := aoc.NewClient(profile.Cookie)
+		_, err := client.SaveInput(day, year, aoc.DayFilename(day, year, profile.InputName()), cache, false)
+		if errors.Is(err, aoc.ErrInputDiffers) {
+			fmt.Printf("%s: %s\n", profile.Name, err)
+		} else if err != nil {
+			log.Fatalf("%s: %s", profile.Name, err)
+		}
+
+		page, err := client.Puzzle(day, year)
+		if err != nil {
+			log.Fatalf("%s: %s", profile.Name, err)
+		}
+		answers[profile.Name] = aoc.ParseAnswers(page)
+		fmt.Printf("%s: %s, %d known answer(s)\n", profile.Name, profile.InputName(), len(answers[profile.Name]))
+	}
+
+	if err := answers.Save(answersFilename); err != nil {
+		log.Fatalf("%s", err)
+	}
+}
+
+func generate(dir string, profiles *aoc.Profiles) {
+	answers, err := aoc.LoadKnownAnswers(filepath.Join(dir, aoc.AnswersName))
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	cases := skeleton.ProfileCases(profiles.All(), answers)
+	if err := skeleton.WriteProfilesTest(dir, cases); err != nil {
+		log.Fatalf("%s", err)
+	}
+	if len(cases) > 0 {
+		fmt.Printf("%s: %d case(s)\n", filepath.Join(dir, skeleton.ProfilesTestName), len(cases))
+	}
+}
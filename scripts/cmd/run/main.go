@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/runner"
+	"github.com/zMoooooritz/advent-of-code/util"
+)
+
+func main() {
+	years := flag.String("year", "", "years to run, e.g. 2023,2024 (default all)")
+	days := flag.String("day", "", "days to run, e.g. 1-10 (default all)")
+	parts := flag.String("part", "1-2", "parts to run")
+	timeout := flag.Duration("timeout", time.Minute, "timeout per part")
+	flag.Parse()
+
+	yearSelected, err := runner.ParseSelection(*years)
+	if err != nil {
+		log.Fatalf("parsing -year: %s", err)
+	}
+	daySelected, err := runner.ParseSelection(*days)
+	if err != nil {
+		log.Fatalf("parsing -day: %s", err)
+	}
+	partSelected, err := runner.ParseSelection(*parts)
+	if err != nil {
+		log.Fatalf("parsing -part: %s", err)
+	}
+
+	r := runner.Runner{Root: filepath.Join(util.Dirname(), "../../.."), Timeout: *timeout}
+	for _, part := range []int{1, 2} {
+		if partSelected(part) {
+			r.Parts = append(r.Parts, part)
+		}
+	}
+
+	results, err := r.Run(func(year, day int) bool {
+		return yearSelected(year) && daySelected(day)
+	})
+	if err != nil {
+		log.Fatalf("running solutions: %s", err)
+	}
+	if len(results) == 0 {
+		log.Fatalf("no solutions selected")
+	}
+	runner.PrintTable(os.Stdout, results)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+	"github.com/zMoooooritz/advent-of-code/scripts/skeleton"
+)
+
+// the local clock may be a little ahead of the server
+const (
+	unlockGrace = 2 * time.Second
+	retries     = 3
+)
+
+func main() {
+	wait := flag.Bool("wait", false, "sleep until the puzzle unlocks, without -day and -year the next puzzle is used")
+	typeFlag := flag.String("type", "int", "answer type of both parts or of part 1 and 2 separated by a comma: int, int64, string or big")
+	shape := flag.String("shape", "", "input shape parseInput is made for, detected from the input if empty")
+	set := flag.String("template", os.Getenv("AOC_TEMPLATE"), "template set in the templates directory, e.g. intcode")
+	day, year, profile := aoc.ParseProfileFlags()
+
+	opts := skeleton.Options{Template: *set}
+	var err error
+	if opts.Types, err = skeleton.ParseAnswerTypes(*typeFlag); err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	explicit := false
+	flag.Visit(func(f *flag.Flag) {
+		explicit = explicit || f.Name == "day" || f.Name == "year"
+	})
+	if *wait && !explicit {
+		day, year = aoc.NextPuzzle(time.Now())
+	}
+
+	unlock := aoc.UnlockTime(day, year)
+	if remaining := time.Until(unlock); remaining > 0 {
+		if !*wait {
+			log.Fatalf("day %d of %d unlocks at %s (in %s), use -wait to sleep until then", day, year, unlock.Local().Format(time.DateTime), remaining.Round(time.Second))
+		}
+		fmt.Printf("waiting for day %d of %d, unlocks at %s (in %s)\n", day, year, unlock.Local().Format(time.DateTime), remaining.Round(time.Second))
+		time.Sleep(remaining + unlockGrace)
+	}
+
+	client := aoc.NewClient(profile.Cookie)
+	page, err := client.Puzzle(day, year)
+	for attempt := 1; errors.Is(err, aoc.ErrLocked) && attempt < retries; attempt++ {
+		time.Sleep(unlockGrace)
+		page, err = client.Puzzle(day, year)
+	}
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	// the skeleton parses the input the way its shape suggests
+	aoc.GetInput(day, year, profile, false)
+
+	if _, err := os.Stat(aoc.DayFilename(day, year, "main.go")); err == nil {
+		fmt.Println("skeleton exists already, skipping")
+	} else {
+		opts.Title, opts.Examples = aoc.ParseTitle(page), aoc.ParseExamples(page)
+		opts.Shape = skeleton.ShapeOf(aoc.DayFilename(day, year, profile.InputName()), opts.Examples)
+		if *shape != "" {
+			if opts.Shape, err = skeleton.ParseShape(*shape); err != nil {
+				log.Fatalf("%s", err)
+			}
+		}
+		skeleton.Run(day, year, opts)
+	}
+
+	promptFilename := aoc.DayFilename(day, year, "prompt.md")
+	if err := aoc.WritePrompt(promptFilename, page); err != nil {
+		log.Fatalf("%s", err)
+	}
+	fmt.Println("Wrote prompt to file: ", promptFilename)
+}
@@ -2,15 +2,48 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"log"
+	"os"
 	"time"
 
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
 	"github.com/zMoooooritz/advent-of-code/scripts/skeleton"
 )
 
 func main() {
-	today := time.Now()
-	day := flag.Int("day", today.Day(), "day number to fetch, 1-25")
-	year := flag.Int("year", today.Year(), "AOC year")
+	currentDay, currentYear := aoc.CurrentPuzzle(time.Now())
+	day := flag.Int("day", currentDay, "day number to fetch, 1-25 (1-12 since 2025)")
+	year := flag.Int("year", currentYear, "AOC year")
+	// optional, used to fill in the title and examples of the puzzle page
+	cookie := flag.String("cookie", os.Getenv("AOC_SESSION_COOKIE"), "AOC session cookie")
+	typeFlag := flag.String("type", "int", "answer type of both parts or of part 1 and 2 separated by a comma: int, int64, string or big")
+	shape := flag.String("shape", "", "input shape parseInput is made for, detected from input.txt or the example if empty")
+	set := flag.String("template", os.Getenv("AOC_TEMPLATE"), "template set in the templates directory, e.g. intcode")
 	flag.Parse()
-	skeleton.Run(*day, *year)
+
+	opts := skeleton.Options{Template: *set}
+	var err error
+	if opts.Types, err = skeleton.ParseAnswerTypes(*typeFlag); err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	if *cookie != "" {
+		page, err := aoc.NewClient(*cookie).Puzzle(*day, *year)
+		if err != nil {
+			fmt.Printf("skipping examples: %v\n", err)
+		} else {
+			opts.Title = aoc.ParseTitle(page)
+			opts.Examples = aoc.ParseExamples(page)
+		}
+	}
+
+	opts.Shape = skeleton.ShapeOf(aoc.DayFilename(*day, *year, "input.txt"), opts.Examples)
+	if *shape != "" {
+		if opts.Shape, err = skeleton.ParseShape(*shape); err != nil {
+			log.Fatalf("%s", err)
+		}
+	}
+	fmt.Printf("input shape: %s\n", opts.Shape)
+	skeleton.Run(*day, *year, opts)
 }
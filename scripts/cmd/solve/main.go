@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/zMoooooritz/advent-of-code/inputs"
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+	"github.com/zMoooooritz/advent-of-code/solution"
+	"github.com/zMoooooritz/advent-of-code/util"
+)
+
+func main() {
+	currentDay, currentYear := aoc.CurrentPuzzle(time.Now())
+	day := flag.Int("day", currentDay, "day number to solve, 1-25 (1-12 since 2025)")
+	year := flag.Int("year", currentYear, "AOC year")
+	part := flag.Int("part", 1, "part 1 or 2")
+	inputName := flag.String("input", "input.txt", "input file in the directory of the day, e.g. input.alt.txt")
+	submit := flag.Bool("submit", false, "submit the answer after solving")
+	// only needed with -submit
+	cookie := flag.String("cookie", os.Getenv("AOC_SESSION_COOKIE"), "AOC session cookie")
+	profileName := flag.String("profile", os.Getenv("AOC_PROFILE"), "session profile from the profiles file")
+	clipboard := flag.Bool("clipboard", true, "copy the answer to the clipboard")
+	list := flag.Bool("list", false, "list the registered solutions as \"year day\" lines")
+	flag.Parse()
+
+	if *list {
+		for _, e := range solution.All() {
+			fmt.Println(e.Year, e.Day)
+		}
+		return
+	}
+
+	// the answer has to be the one of the input of the submitting profile
+	var profile aoc.Profile
+	if *submit {
+		var err error
+		if profile, err = aoc.ResolveProfile(*profileName, *cookie); err != nil {
+			log.Fatalf("%s", err)
+		}
+		if !isFlagSet("input") {
+			*inputName = profile.InputName()
+		}
+	}
+
+	new, ok := solution.Get(*year, *day)
+	if !ok {
+		log.Fatalf("no solution registered for %d-day%02d", *year, *day)
+	}
+
+	input, err := inputs.Read(aoc.DayFilename(*day, *year, *inputName))
+	if errors.Is(err, inputs.ErrMissing) {
+		log.Fatalf("%s, fetch it with make input DAY=%d YEAR=%d", err, *day, *year)
+	}
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	fmt.Println("Running part", *part)
+	ans, err := solution.Solve(new, input, *part)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	answer, err := solution.Format(ans)
+	if err != nil {
+		log.Fatalf("part %d: %s", *part, err)
+	}
+	if *clipboard {
+		util.CopyToClipboard(answer)
+	}
+	fmt.Println("Output:", answer)
+
+	if *submit {
+		result := aoc.Submit(*day, *year, *part, answer, profile)
+		fmt.Println("Result:", result)
+		fmt.Println(result.Message)
+	}
+}
+
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		set = set || f.Name == name
+	})
+	return set
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+	"github.com/zMoooooritz/advent-of-code/scripts/runner"
+	"github.com/zMoooooritz/advent-of-code/scripts/status"
+	"github.com/zMoooooritz/advent-of-code/util"
+)
+
+func main() {
+	years := flag.String("year", "", "years to show, e.g. 2023,2024 (default all)")
+	calendar := flag.Bool("calendar", false, "add the stars of the calendar pages, requires a session cookie")
+	cookie := flag.String("cookie", os.Getenv("AOC_SESSION_COOKIE"), "AOC session cookie")
+	flag.Parse()
+
+	yearSelected, err := runner.ParseSelection(*years)
+	if err != nil {
+		log.Fatalf("parsing -year: %s", err)
+	}
+
+	scanned, err := status.Scan(filepath.Join(util.Dirname(), "../../.."))
+	if err != nil {
+		log.Fatalf("scanning solutions: %s", err)
+	}
+
+	if *calendar && *cookie == "" {
+		log.Fatalf("no session cookie set on flag or env var (AOC_SESSION_COOKIE)")
+	}
+	client := aoc.NewClient(*cookie)
+
+	selected := []status.Year{}
+	for _, year := range scanned {
+		if !yearSelected(year.Year) {
+			continue
+		}
+		if *calendar {
+			stars, err := client.Calendar(year.Year)
+			if err != nil {
+				log.Fatalf("%s", err)
+			}
+			year.AddCalendar(stars)
+		}
+		selected = append(selected, year)
+	}
+
+	status.WriteGrid(os.Stdout, selected)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+func main() {
+	level := flag.Int("part", 1, "part 1 or 2")
+	answer := flag.String("answer", "", "answer to submit")
+	day, year, profile := aoc.ParseProfileFlags()
+
+	if *level != 1 && *level != 2 {
+		log.Fatalf("part must be 1 or 2, got %d", *level)
+	}
+	if *answer == "" {
+		log.Fatalf("no answer given on -answer flag")
+	}
+
+	result := aoc.Submit(day, year, *level, *answer, profile)
+	fmt.Println("Result:", result)
+	fmt.Println(result.Message)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+	"github.com/zMoooooritz/advent-of-code/scripts/skeleton"
+)
+
+func main() {
+	day, year, cookie := aoc.ParseFlags()
+
+	result, err := aoc.NewClient(cookie).Sync(day, year, aoc.DayFilename(day, year, "prompt.md"))
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+	fmt.Printf("day %d, year %d: %d part(s) unlocked, %d solved\n", day, year, result.Parts, len(result.Answers))
+	if result.Updated {
+		fmt.Println("updated prompt.md")
+	}
+
+	changes, err := skeleton.UpdateTests(aoc.DayFilename(day, year, "main_test.go"), result.Answers, result.Examples)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Println("no main_test.go yet, make one with `make skeleton`")
+		return
+	} else if err != nil {
+		log.Fatalf("updating tests: %s", err)
+	}
+	for _, change := range changes {
+		fmt.Println("main_test.go:", change)
+	}
+}
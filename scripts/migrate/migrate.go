@@ -0,0 +1,403 @@
+// Package migrate rewrites the package main days into registered solutions.
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	solutionImport = "github.com/zMoooooritz/advent-of-code/solution"
+	inputsImport   = "github.com/zMoooooritz/advent-of-code/inputs"
+)
+
+// edit replaces src[start:end] with text
+type edit struct {
+	start, end int
+	text       string
+}
+
+func apply(src []byte, edits []edit) []byte {
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	for _, e := range edits {
+		src = append(src[:e.start:e.start], append([]byte(e.text), src[e.end:]...)...)
+	}
+	return src
+}
+
+// Day migrates the package main day in dir: main.go loses main() and the
+// input embedding and registers part1 and part2 instead, the test files move
+// into the new package and load the input like Inputs does. Days that are not
+// package main are left alone, changed reports whether anything was written.
+func Day(dir string, year, day int) (changed bool, err error) {
+	pkg := fmt.Sprintf("day%02d", day)
+	mainFilename := filepath.Join(dir, "main.go")
+
+	src, err := os.ReadFile(mainFilename)
+	if err != nil {
+		return false, err
+	}
+	migrated, ok, err := migrateMain(src, pkg, year, day)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return false, err
+	}
+	outputs := map[string][]byte{mainFilename: migrated}
+	for _, filename := range files {
+		if filename == mainFilename {
+			continue
+		}
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return false, err
+		}
+		if outputs[filename], err = migrateOther(src, pkg, filepath.Base(filename) == "main_test.go"); err != nil {
+			return false, fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+
+	for filename, content := range outputs {
+		if err := os.WriteFile(filename, content, os.FileMode(0644)); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func migrateMain(src []byte, pkg string, year, day int) ([]byte, bool, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "main.go", src, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+	if file.Name.Name != "main" {
+		return nil, false, nil
+	}
+
+	offset := func(pos token.Pos) int { return fset.Position(pos).Offset }
+	register := fmt.Sprintf("func init() {\n\tsolution.Register(%d, %d, solution.Funcs(part1, part2))\n}", year, day)
+
+	edits := []edit{{offset(file.Name.Pos()), offset(file.Name.End()), pkg}}
+	registered := false
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv != nil {
+				continue
+			}
+			start := offset(decl.Pos())
+			if decl.Doc != nil {
+				start = offset(decl.Doc.Pos())
+			}
+			switch {
+			case decl.Name.Name == "main":
+				edits = append(edits, edit{start, lineEnd(src, offset(decl.End())), ""})
+			case decl.Name.Name == "init" && usesInput(decl) && !registered:
+				edits = append(edits, edit{start, offset(decl.End()), register})
+				registered = true
+			}
+		case *ast.GenDecl:
+			if isInputEmbed(decl) {
+				start := offset(decl.Pos())
+				if decl.Doc != nil {
+					start = offset(decl.Doc.Pos())
+				}
+				edits = append(edits, edit{start, lineEnd(src, offset(decl.End())), ""})
+			}
+		}
+	}
+	if !registered {
+		return nil, false, fmt.Errorf("no init function trimming the input found")
+	}
+
+	out, err := fixImports(apply(slices.Clone(src), edits), solutionImport)
+	return out, err == nil, err
+}
+
+// migrateOther renames the package of the remaining files, the test file
+// additionally loads the input that main.go no longer provides
+func migrateOther(src []byte, pkg string, isMainTest bool) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments|parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+	offset := func(pos token.Pos) int { return fset.Position(pos).Offset }
+
+	out := apply(slices.Clone(src), []edit{{offset(file.Name.Pos()), offset(file.Name.End()), pkg}})
+	if !isMainTest {
+		return format.Source(out)
+	}
+	out, _, err = loadInput(out)
+	return out, err
+}
+
+// Inputs moves the main_test.go of a registered day from embedding input.txt
+// to the shared loader of the inputs package, the table cases are skipped
+// when the input is missing. changed reports whether the file was written.
+func Inputs(dir string) (changed bool, err error) {
+	filename := filepath.Join(dir, "main_test.go")
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	out, changed, err := loadInput(src)
+	if err != nil || !changed {
+		return false, err
+	}
+	return true, os.WriteFile(filename, out, os.FileMode(0644))
+}
+
+// loadInput replaces the embedded input of a test file by inputs.Load and
+// inserts inputs.SkipMissing into every t.Run of a test table
+func loadInput(src []byte) ([]byte, bool, error) {
+	if bytes.Contains(src, []byte("inputs.Load()")) {
+		return src, false, nil
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "main_test.go", src, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+	offset := func(pos token.Pos) int { return fset.Position(pos).Offset }
+
+	load := "var input = inputs.Load()"
+	edits := []edit{}
+	replaced := false
+	importsEnd := offset(file.Name.End())
+	for _, decl := range file.Decls {
+		start := offset(decl.Pos())
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT {
+				importsEnd = offset(decl.End())
+			}
+			if isInputEmbed(decl) && !replaced {
+				if decl.Doc != nil {
+					start = offset(decl.Doc.Pos())
+				}
+				edits = append(edits, edit{start, offset(decl.End()), load})
+				replaced = true
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil && decl.Name.Name == "init" && usesInput(decl) {
+				if decl.Doc != nil {
+					start = offset(decl.Doc.Pos())
+				}
+				edits = append(edits, edit{start, lineEnd(src, offset(decl.End())), ""})
+			}
+		}
+	}
+	if !replaced {
+		edits = append(edits, edit{importsEnd, importsEnd, "\n\n" + load})
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		lit, isLit := call.Args[1].(*ast.FuncLit)
+		if !ok || !isLit || sel.Sel.Name != "Run" || !usesCaseInput(lit.Body) {
+			return true
+		}
+		params := lit.Type.Params.List
+		if len(params) != 1 || len(params[0].Names) != 1 {
+			return true
+		}
+		at := offset(lit.Body.Lbrace) + 1
+		edits = append(edits, edit{at, at, fmt.Sprintf("\ninputs.SkipMissing(%s, tt.input)", params[0].Names[0].Name)})
+		return true
+	})
+
+	out, err := fixImports(apply(slices.Clone(src), edits), inputsImport)
+	return out, err == nil, err
+}
+
+// lineEnd extends the end of a removed declaration by its newline and one
+// following blank line
+func lineEnd(src []byte, end int) int {
+	for range 2 {
+		if end < len(src) && src[end] == '\n' {
+			end++
+		}
+	}
+	return end
+}
+
+func usesInput(fn *ast.FuncDecl) bool {
+	found := false
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == "input" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// usesCaseInput reports whether the body runs on the input of a table case
+func usesCaseInput(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "tt" && sel.Sel.Name == "input" {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func isInputEmbed(decl *ast.GenDecl) bool {
+	if decl.Tok != token.VAR || decl.Doc == nil {
+		return false
+	}
+	embed := false
+	for _, comment := range decl.Doc.List {
+		embed = embed || strings.HasPrefix(comment.Text, "//go:embed")
+	}
+	if !embed {
+		return false
+	}
+	for _, spec := range decl.Specs {
+		for _, name := range spec.(*ast.ValueSpec).Names {
+			if name.Name == "input" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fixImports rewrites the import block: imports that are no longer used are
+// dropped and the given paths are added, "embed" is added as blank import.
+// Standard library imports come first, followed by the others.
+func fixImports(src []byte, add ...string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	embeds := bytes.Contains(src, []byte("//go:embed"))
+
+	type spec struct{ name, path string }
+	specs := []spec{}
+	seen := map[string]bool{}
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := ""
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		keep := used[importName(name, path)]
+		if name == "_" {
+			keep = path != "embed" || embeds
+		}
+		if keep && !seen[path] {
+			specs = append(specs, spec{name, path})
+			seen[path] = true
+		}
+	}
+	for _, path := range add {
+		if !seen[path] {
+			name := ""
+			if path == "embed" {
+				name = "_"
+			}
+			specs = append(specs, spec{name, path})
+			seen[path] = true
+		}
+	}
+
+	std, other := []string{}, []string{}
+	for _, s := range specs {
+		line := strconv.Quote(s.path)
+		if s.name != "" {
+			line = s.name + " " + line
+		}
+		if strings.Contains(strings.Split(s.path, "/")[0], ".") {
+			other = append(other, "\t"+line)
+		} else {
+			std = append(std, "\t"+line)
+		}
+	}
+	sort.Slice(std, func(i, j int) bool { return importSortKey(std[i]) < importSortKey(std[j]) })
+	sort.Slice(other, func(i, j int) bool { return importSortKey(other[i]) < importSortKey(other[j]) })
+
+	groups := []string{}
+	for _, group := range [][]string{std, other} {
+		if len(group) > 0 {
+			groups = append(groups, strings.Join(group, "\n"))
+		}
+	}
+	block := "import (\n" + strings.Join(groups, "\n\n") + "\n)"
+
+	var start, end int
+	found := false
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			if !found {
+				start = fset.Position(gen.Pos()).Offset
+			}
+			end = fset.Position(gen.End()).Offset
+			found = true
+		}
+	}
+	if !found {
+		start = fset.Position(file.Name.End()).Offset
+		end = start
+		block = "\n\n" + block
+	}
+	return format.Source(apply(slices.Clone(src), []edit{{start, end, block}}))
+}
+
+// importName is the name a package is referred to by in the file
+func importName(name, path string) string {
+	if name != "" {
+		return name
+	}
+	elements := strings.Split(path, "/")
+	last := elements[len(elements)-1]
+	if len(elements) > 1 && len(last) > 1 && last[0] == 'v' {
+		if _, err := strconv.Atoi(last[1:]); err == nil {
+			return elements[len(elements)-2]
+		}
+	}
+	return last
+}
+
+// importSortKey sorts by path like gofmt does, ignoring the name
+func importSortKey(line string) string {
+	return line[strings.Index(line, `"`):]
+}
@@ -0,0 +1,150 @@
+package migrate
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func copyDay(t *testing.T, dir string) {
+	t.Helper()
+	for _, name := range []string{"main.go", "main_test.go"} {
+		content, err := os.ReadFile(filepath.Join("testdata/day07", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDay(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "day07")
+	os.Mkdir(dir, os.ModePerm)
+	copyDay(t, dir)
+
+	changed, err := Day(dir, 2024, 7)
+	if err != nil || !changed {
+		t.Fatalf("Day() = %v, %v, want a migration", changed, err)
+	}
+
+	main, _ := os.ReadFile(filepath.Join(dir, "main.go"))
+	wantMain := "package day07\n\nimport (\n\t\"strings\"\n\n\t\"github.com/zMoooooritz/advent-of-code/solution\"\n)\n\n" +
+		"func init() {\n\tsolution.Register(2024, 7, solution.Funcs(part1, part2))\n}\n\nfunc part1(input string) int {\n"
+	if !strings.HasPrefix(string(main), wantMain) {
+		t.Errorf("main.go =\n%s\nwant prefix\n%s", main, wantMain)
+	}
+	for _, gone := range []string{"func main()", "go:embed", "flag", "util"} {
+		if strings.Contains(string(main), gone) {
+			t.Errorf("main.go still contains %q", gone)
+		}
+	}
+
+	test, _ := os.ReadFile(filepath.Join(dir, "main_test.go"))
+	wantTest := "package day07\n\nimport (\n\t\"testing\"\n\n\t\"github.com/zMoooooritz/advent-of-code/inputs\"\n)\n\n" +
+		"var input = inputs.Load()\n\nvar example = ``\n"
+	if !strings.HasPrefix(string(test), wantTest) {
+		t.Errorf("main_test.go =\n%s\nwant prefix\n%s", test, wantTest)
+	}
+	if got := strings.Count(string(test), "\t\t\tinputs.SkipMissing(t, tt.input)\n\t\t\tif got := part"); got != 2 {
+		t.Errorf("main_test.go skips %d tables on a missing input, want 2:\n%s", got, test)
+	}
+
+	if changed, err := Day(dir, 2024, 7); err != nil || changed {
+		t.Errorf("Day() of a migrated day = %v, %v, want no change", changed, err)
+	}
+}
+
+const embeddingTest = `package day07
+
+import (
+	_ "embed"
+	"strings"
+	"testing"
+)
+
+//go:embed input.txt
+var input string
+
+func init() {
+	input = strings.TrimRight(input, "\n")
+}
+
+var example = "1 2"
+
+func Test_part1(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{"example", example, 3},
+		{"actual", input, 42},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := part1(strings.TrimSpace(tt.input)); got != tt.want {
+				t.Errorf("part1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+`
+
+func TestInputs(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "main_test.go")
+	os.WriteFile(filename, []byte(embeddingTest), 0644)
+
+	changed, err := Inputs(dir)
+	if err != nil || !changed {
+		t.Fatalf("Inputs() = %v, %v, want a migration", changed, err)
+	}
+
+	test, _ := os.ReadFile(filename)
+	want := "package day07\n\nimport (\n\t\"strings\"\n\t\"testing\"\n\n\t\"github.com/zMoooooritz/advent-of-code/inputs\"\n)\n\n" +
+		"var input = inputs.Load()\n\nvar example = \"1 2\"\n"
+	if !strings.HasPrefix(string(test), want) {
+		t.Errorf("main_test.go =\n%s\nwant prefix\n%s", test, want)
+	}
+	if !strings.Contains(string(test), "t.Run(tt.name, func(t *testing.T) {\n\t\t\tinputs.SkipMissing(t, tt.input)\n") {
+		t.Errorf("main_test.go does not skip on a missing input:\n%s", test)
+	}
+	for _, gone := range []string{"embed", "func init()"} {
+		if strings.Contains(string(test), gone) {
+			t.Errorf("main_test.go still contains %q", gone)
+		}
+	}
+
+	if changed, err := Inputs(dir); err != nil || changed {
+		t.Errorf("Inputs() of a migrated day = %v, %v, want no change", changed, err)
+	}
+	if changed, err := Inputs(t.TempDir()); err != nil || changed {
+		t.Errorf("Inputs() without test file = %v, %v, want no change", changed, err)
+	}
+}
+
+func TestDayBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a package")
+	}
+	// the day has to live inside the module to import the solution package
+	dir, err := os.MkdirTemp(".", "day07-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	copyDay(t, dir)
+
+	if _, err := Day(dir, 2024, 7); err != nil {
+		t.Fatalf("Day() error = %v", err)
+	}
+	// without an input.txt
+	out, err := exec.Command("go", "vet", "./"+filepath.Base(dir)).CombinedOutput()
+	if err != nil {
+		t.Errorf("go vet of the migrated day failed: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,357 @@
+// Package runner finds the solutions in this repo and runs a selection of them.
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// DaysFilename is the generated file of the solve command importing every day
+const DaysFilename = "scripts/cmd/solve/days.go"
+
+// Solution is a single day of the repo
+type Solution struct {
+	Year int
+	Day  int
+	Dir  string
+}
+
+func (s Solution) String() string {
+	return fmt.Sprintf("%d-day%02d", s.Year, s.Day)
+}
+
+var dayDirPattern = regexp.MustCompile(`^day(\d{2})$`)
+
+// DayDirs returns every YYYY/dayNN directory below root that contains a
+// main.go, ordered by year and day
+func DayDirs(root string) ([]Solution, error) {
+	yearDirs, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", root, err)
+	}
+
+	solutions := []Solution{}
+	for _, yearDir := range yearDirs {
+		year, err := strconv.Atoi(yearDir.Name())
+		if err != nil || !yearDir.IsDir() {
+			continue
+		}
+
+		dayDirs, err := os.ReadDir(filepath.Join(root, yearDir.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", yearDir.Name(), err)
+		}
+		for _, dayDir := range dayDirs {
+			match := dayDirPattern.FindStringSubmatch(dayDir.Name())
+			if match == nil || !dayDir.IsDir() {
+				continue
+			}
+			dir := filepath.Join(root, yearDir.Name(), dayDir.Name())
+			if _, err := os.Stat(filepath.Join(dir, "main.go")); err != nil {
+				continue
+			}
+			day, _ := strconv.Atoi(match[1])
+			solutions = append(solutions, Solution{year, day, dir})
+		}
+	}
+
+	slices.SortFunc(solutions, func(a, b Solution) int {
+		if a.Year != b.Year {
+			return a.Year - b.Year
+		}
+		return a.Day - b.Day
+	})
+	return solutions, nil
+}
+
+// ModulePath reads the module directive of the go.mod file in root
+func ModulePath(root string) (string, error) {
+	filename := filepath.Join(root, "go.mod")
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	return "", fmt.Errorf("no module directive in %s", filename)
+}
+
+// ParseSelection parses comma separated numbers and inclusive ranges like
+// "1-10,12", an empty selection selects everything
+func ParseSelection(selection string) (func(int) bool, error) {
+	if strings.TrimSpace(selection) == "" {
+		return func(int) bool { return true }, nil
+	}
+
+	type span struct{ from, to int }
+	spans := []span{}
+	for _, part := range strings.Split(selection, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+		from, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid selection %q", part)
+		}
+		to := from
+		if len(bounds) == 2 {
+			to, err = strconv.Atoi(bounds[1])
+			if err != nil || to < from {
+				return nil, fmt.Errorf("invalid selection %q", part)
+			}
+		}
+		spans = append(spans, span{from, to})
+	}
+
+	return func(n int) bool {
+		for _, s := range spans {
+			if s.from <= n && n <= s.to {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// Result of running one part of a solution, Err is set if the solution did
+// not build, failed or timed out
+type Result struct {
+	Solution Solution
+	Part     int
+	Answer   string
+	Duration time.Duration
+	Err      error
+}
+
+var outputPattern = regexp.MustCompile(`(?m)^Output: (.*)$`)
+
+// parseAnswer extracts the answer from the Output: line printed by solve
+func parseAnswer(stdout []byte) (string, error) {
+	matches := outputPattern.FindAllSubmatch(stdout, -1)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("no Output: line printed")
+	}
+	return strings.TrimSpace(string(matches[len(matches)-1][1])), nil
+}
+
+// Runner builds the solve command and runs the parts of the selected
+// solutions with it
+type Runner struct {
+	// Root is the repository root containing scripts/cmd/solve
+	Root    string
+	Parts   []int
+	Timeout time.Duration
+}
+
+// Run runs the parts of every registered solution accepted by selected and
+// returns one result per part, a failing or timed out part only fails its
+// own result. If the solve command does not build with every day, each
+// selected day is built on its own and a build error only fails the parts
+// of that day.
+func (r Runner) Run(selected func(year, day int) bool) ([]Result, error) {
+	buildDir, err := os.MkdirTemp("", "aoc-run")
+	if err != nil {
+		return nil, fmt.Errorf("making build directory: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	binary := filepath.Join(buildDir, "solve")
+	if err := build(r.Root, binary, ""); err == nil {
+		solutions, err := registered(binary, r.Root)
+		if err != nil {
+			return nil, err
+		}
+		results := []Result{}
+		for _, s := range solutions {
+			if selected(s.Year, s.Day) {
+				results = append(results, r.runParts(binary, s, nil)...)
+			}
+		}
+		return results, nil
+	}
+
+	// the registry is only known to the solve command, without it the day
+	// directories are the best guess of what is registered
+	solutions, err := DayDirs(r.Root)
+	if err != nil {
+		return nil, err
+	}
+	results := []Result{}
+	for _, s := range solutions {
+		if !selected(s.Year, s.Day) {
+			continue
+		}
+		binary := filepath.Join(buildDir, s.String())
+		buildErr := r.buildDay(buildDir, binary, s)
+		results = append(results, r.runParts(binary, s, buildErr)...)
+	}
+	return results, nil
+}
+
+// runParts runs every part of the solution, or fails them with buildErr
+func (r Runner) runParts(binary string, s Solution, buildErr error) []Result {
+	results := []Result{}
+	for _, part := range r.Parts {
+		result := Result{Solution: s, Part: part, Err: buildErr}
+		if buildErr == nil {
+			result.Answer, result.Duration, result.Err = r.runPart(binary, s, part)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// registered lists the solutions registered in the solve command
+func registered(binary, root string) ([]Solution, error) {
+	out, err := exec.Command(binary, "-list").Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing solutions: %w", err)
+	}
+	return parseList(out, root)
+}
+
+// parseList parses the "year day" lines printed by solve -list
+func parseList(out []byte, root string) ([]Solution, error) {
+	solutions := []Solution{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" {
+			continue
+		}
+		var s Solution
+		if _, err := fmt.Sscanf(line, "%d %d", &s.Year, &s.Day); err != nil {
+			return nil, fmt.Errorf("invalid solution %q: %w", line, err)
+		}
+		s.Dir = filepath.Join(root, strconv.Itoa(s.Year), fmt.Sprintf("day%02d", s.Day))
+		solutions = append(solutions, s)
+	}
+	return solutions, nil
+}
+
+// buildDay builds the solve command with only the given day imported, by
+// overlaying the generated days file
+func (r Runner) buildDay(buildDir, binary string, s Solution) error {
+	module, err := ModulePath(r.Root)
+	if err != nil {
+		return err
+	}
+	days := filepath.Join(buildDir, s.String()+".go")
+	content := fmt.Sprintf("package main\n\nimport _ %q\n", fmt.Sprintf("%s/%d/day%02d", module, s.Year, s.Day))
+	if err := os.WriteFile(days, []byte(content), 0644); err != nil {
+		return err
+	}
+
+	root, err := filepath.Abs(r.Root)
+	if err != nil {
+		return err
+	}
+	overlay, err := json.Marshal(map[string]map[string]string{
+		"Replace": {filepath.Join(root, DaysFilename): days},
+	})
+	if err != nil {
+		return err
+	}
+	overlayFile := filepath.Join(buildDir, s.String()+".json")
+	if err := os.WriteFile(overlayFile, overlay, 0644); err != nil {
+		return err
+	}
+	return build(r.Root, binary, overlayFile)
+}
+
+func build(root, binary, overlay string) error {
+	args := []string{"build", "-o", binary}
+	if overlay != "" {
+		args = append(args, "-overlay", overlay)
+	}
+	cmd := exec.Command("go", append(args, "./scripts/cmd/solve")...)
+	cmd.Dir = root
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("build failed: %s", firstLine(out, err))
+	}
+	return nil
+}
+
+func (r Runner) runPart(binary string, s Solution, part int) (string, time.Duration, error) {
+	ctx := context.Background()
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	// a batch run must not overwrite the clipboard once per part
+	cmd := exec.CommandContext(ctx, binary, "-year", strconv.Itoa(s.Year), "-day", strconv.Itoa(s.Day), "-part", strconv.Itoa(part), "-clipboard=false")
+	cmd.Dir = s.Dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	start := time.Now()
+	err := cmd.Run()
+	duration := time.Since(start)
+
+	if ctx.Err() != nil {
+		return "", duration, fmt.Errorf("timed out after %s", r.Timeout)
+	}
+	if err != nil {
+		return "", duration, fmt.Errorf("failed: %s", firstLine(stderr.Bytes(), err))
+	}
+	answer, err := parseAnswer(stdout.Bytes())
+	return answer, duration, err
+}
+
+// firstLine shortens compiler errors and panics to fit into the table
+func firstLine(out []byte, err error) string {
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return line
+		}
+	}
+	return err.Error()
+}
+
+// PrintTable writes the results as aligned table
+func PrintTable(w io.Writer, results []Result) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "YEAR\tDAY\tPART\tANSWER\tTIME\tSTATUS")
+
+	failed := 0
+	var total time.Duration
+	for _, r := range results {
+		status := "ok"
+		if r.Err != nil {
+			status = r.Err.Error()
+			failed++
+		}
+		total += r.Duration
+		fmt.Fprintf(tw, "%d\t%02d\t%d\t%s\t%s\t%s\n", r.Solution.Year, r.Solution.Day, r.Part, r.Answer, formatDuration(r.Duration), status)
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\n%d parts, %d failed, total time %s\n", len(results), failed, formatDuration(total))
+}
+
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.Round(10 * time.Microsecond).String()
+}
@@ -0,0 +1,145 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestParseSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection string
+		want      []int
+		wantErr   bool
+	}{
+		{"empty", "", []int{1, 2, 3, 4, 5}, false},
+		{"single", "3", []int{3}, false},
+		{"range", "2-4", []int{2, 3, 4}, false},
+		{"mixed", "1, 3-4", []int{1, 3, 4}, false},
+		{"reversed", "4-2", nil, true},
+		{"garbage", "a", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := ParseSelection(tt.selection)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSelection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := []int{}
+			for n := 1; n <= 5; n++ {
+				if selected(n) {
+					got = append(got, n)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseSelection() selects %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAnswer(t *testing.T) {
+	got, err := parseAnswer([]byte("Running part 1\nsome debug output\nOutput: 4,6,3\n"))
+	if err != nil || got != "4,6,3" {
+		t.Errorf("parseAnswer() = %q, %v, want 4,6,3", got, err)
+	}
+	if _, err := parseAnswer([]byte("Running part 1\n")); err == nil {
+		t.Errorf("parseAnswer() without Output: line should fail")
+	}
+}
+
+func TestDayDirs(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"2024/day02", "2024/day10", "2019/day01", "2024/notes", "scripts/day01"} {
+		os.MkdirAll(filepath.Join(root, dir), os.ModePerm)
+		os.WriteFile(filepath.Join(root, dir, "main.go"), []byte("package main\n"), 0644)
+	}
+	os.MkdirAll(filepath.Join(root, "2024/day03"), os.ModePerm)
+
+	solutions, err := DayDirs(root)
+	if err != nil {
+		t.Fatalf("DayDirs() error = %v", err)
+	}
+	got := []string{}
+	for _, s := range solutions {
+		got = append(got, s.String())
+	}
+	want := []string{"2019-day01", "2024-day02", "2024-day10"}
+	if !slices.Equal(got, want) {
+		t.Errorf("DayDirs() = %v, want %v", got, want)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	solutions, err := parseList([]byte("2019 1\n2024 10\n"), "root")
+	if err != nil {
+		t.Fatalf("parseList() error = %v", err)
+	}
+	want := []Solution{{2019, 1, filepath.Join("root", "2019", "day01")}, {2024, 10, filepath.Join("root", "2024", "day10")}}
+	if !slices.Equal(solutions, want) {
+		t.Errorf("parseList() = %v, want %v", solutions, want)
+	}
+
+	if _, err := parseList([]byte("2024 x\n"), "root"); err == nil {
+		t.Errorf("parseList() of invalid line succeeded")
+	}
+}
+
+// solveMain stands in for the solve command, it fails unless the clipboard
+// is left alone
+const solveMain = `package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+func main() {
+	flag.Int("year", 0, "")
+	flag.Int("day", 0, "")
+	flag.Int("part", 0, "")
+	clipboard := flag.Bool("clipboard", true, "")
+	flag.Parse()
+	if *clipboard {
+		panic("clipboard used")
+	}
+	fmt.Println("Output: 42")
+}
+`
+
+func TestRunBrokenDay(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the solve command")
+	}
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":                    "module example.com/aoc\n\ngo 1.23\n",
+		"scripts/cmd/solve/main.go": solveMain,
+		DaysFilename:                "package main\n\nimport (\n\t_ \"example.com/aoc/2024/day01\"\n\t_ \"example.com/aoc/2024/day02\"\n)\n",
+		"2024/day01/main.go":        "package day01\n",
+		"2024/day02/main.go":        "package day02\n\nvar broken int = \"\"\n",
+	}
+	for name, content := range files {
+		os.MkdirAll(filepath.Dir(filepath.Join(root, name)), os.ModePerm)
+		os.WriteFile(filepath.Join(root, name), []byte(content), 0644)
+	}
+
+	results, err := Runner{Root: root, Parts: []int{1}}.Run(func(int, int) bool { return true })
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Run() = %v, want 2 results", results)
+	}
+	if results[0].Err != nil || results[0].Answer != "42" {
+		t.Errorf("Run() of 2024-day01 = %q, %v, want 42", results[0].Answer, results[0].Err)
+	}
+	if results[1].Err == nil {
+		t.Errorf("Run() of broken 2024-day02 succeeded")
+	}
+}
@@ -0,0 +1,105 @@
+package skeleton
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// AnswerType is a type the parts of a generated day can return, the fields
+// are used by the templates
+type AnswerType struct {
+	Name string // value of the -type flag
+	Go   string // return type of the part
+	Zero string // returned by the skeleton
+	Want string // type of the want field of the test tables
+	Got  string // compared with want, got is the returned answer
+}
+
+var answerTypes = []AnswerType{
+	{Name: "int", Go: "int", Zero: "0", Want: "int", Got: "got"},
+	{Name: "int64", Go: "int64", Zero: "0", Want: "int64", Got: "got"},
+	{Name: "string", Go: "string", Zero: `""`, Want: "string", Got: "got"},
+	{Name: "big", Go: "*big.Int", Zero: "new(big.Int)", Want: "string", Got: "got.String()"},
+}
+
+// IntAnswers is the answer type of both parts unless another one is declared
+var IntAnswers = [2]AnswerType{answerTypes[0], answerTypes[0]}
+
+// ParseAnswerTypes parses the -type flag: a single type for both parts or
+// the types of part 1 and 2 separated by a comma, e.g. "string,int"
+func ParseAnswerTypes(flag string) ([2]AnswerType, error) {
+	names := strings.Split(flag, ",")
+	if len(names) > 2 {
+		return IntAnswers, fmt.Errorf("invalid answer types %q, give at most two", flag)
+	}
+	if len(names) == 1 {
+		names = append(names, names[0])
+	}
+
+	types := IntAnswers
+	for index, name := range names {
+		t, ok := answerType(strings.TrimSpace(name))
+		if !ok {
+			return IntAnswers, fmt.Errorf("unknown answer type %q, use one of int, int64, string or big", name)
+		}
+		types[index] = t
+	}
+	return types, nil
+}
+
+func answerType(name string) (AnswerType, bool) {
+	for _, t := range answerTypes {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return AnswerType{}, false
+}
+
+// tableType returns the answer type of an existing test table by the type
+// of its want field and the comparison with it
+func tableType(want, body string) (AnswerType, bool) {
+	for _, t := range answerTypes {
+		if t.Want == want && strings.Contains(body, t.Got+" != tt.want") {
+			return t, true
+		}
+	}
+	return AnswerType{}, false
+}
+
+// UsesBig reports whether the type needs the math/big import
+func (t AnswerType) UsesBig() bool {
+	return strings.Contains(t.Go, "big.")
+}
+
+// WantZero is the want of a test case whose answer is not known yet, it
+// matches the zero the skeleton returns
+func (t AnswerType) WantZero() string {
+	if t.Name == "big" {
+		return `"0"`
+	}
+	if t.Want == "string" {
+		return `""`
+	}
+	return "0"
+}
+
+// literal returns answer as value of the want field, ok is false if the
+// answer does not fit the type
+func (t AnswerType) literal(answer string) (string, bool) {
+	switch t.Name {
+	case "int":
+		_, err := strconv.Atoi(answer)
+		return answer, err == nil
+	case "int64":
+		_, err := strconv.ParseInt(answer, 10, 64)
+		return answer, err == nil
+	case "big":
+		_, ok := new(big.Int).SetString(answer, 10)
+		return strconv.Quote(answer), ok
+	default:
+		return strconv.Quote(answer), true
+	}
+}
@@ -0,0 +1,37 @@
+package skeleton
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/runner"
+)
+
+// WriteDays regenerates the imports of every day below root, so that the
+// solve command knows all registered solutions
+func WriteDays(root string) error {
+	module, err := runner.ModulePath(root)
+	if err != nil {
+		return err
+	}
+	solutions, err := runner.DayDirs(root)
+	if err != nil {
+		return err
+	}
+
+	imports := []string{}
+	for _, s := range solutions {
+		imports = append(imports, fmt.Sprintf("%s/%d/day%02d", module, s.Year, s.Day))
+	}
+
+	ts, err := parseTemplates()
+	if err != nil {
+		return fmt.Errorf("parsing tmpls directory: %w", err)
+	}
+	content, err := render(ts, "days.go.tmpl", imports)
+	if err != nil {
+		return fmt.Errorf("rendering %s: %w", runner.DaysFilename, err)
+	}
+	return os.WriteFile(filepath.Join(root, runner.DaysFilename), content, os.FileMode(0644))
+}
@@ -0,0 +1,83 @@
+package skeleton
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+// example is a package level variable of the generated test file
+type example struct {
+	Name  string
+	Input string
+}
+
+// testCase is the example entry of a generated test table, Comment keeps an
+// answer that does not fit the want field of the answer type
+type testCase struct {
+	Type    AnswerType
+	Input   string
+	Want    string
+	Comment string
+}
+
+type templateData struct {
+	Year     int
+	Day      int
+	Package  string
+	Title    string
+	Shape    Shape
+	Examples []example
+	Part1    testCase
+	Part2    testCase
+}
+
+// UsesBig reports whether one of the parts returns a *big.Int
+func (d templateData) UsesBig() bool {
+	return d.Part1.Type.UsesBig() || d.Part2.Type.UsesBig()
+}
+
+// newTemplateData fills the example test cases from the examples of the
+// unlocked parts, a part without its own example block reuses the first one
+func newTemplateData(parts []aoc.PartExamples, types [2]AnswerType) templateData {
+	data := templateData{
+		Examples: []example{{Name: "example"}},
+		Part1:    testCase{Type: types[0], Input: "example", Want: types[0].WantZero()},
+		Part2:    testCase{Type: types[1], Input: "example", Want: types[1].WantZero()},
+	}
+
+	for index, part := range parts[:min(len(parts), 2)] {
+		tc := &data.Part1
+		if index == 1 {
+			tc = &data.Part2
+		}
+
+		if input, ok := part.Input(); ok {
+			if index == 0 {
+				data.Examples[0].Input = input
+			} else if input != data.Examples[0].Input {
+				data.Examples = append(data.Examples, example{Name: "example2", Input: input})
+				tc.Input = "example2"
+			}
+		}
+
+		if answer, ok := part.Answer(); ok {
+			if literal, ok := tc.Type.literal(answer); ok {
+				tc.Want = literal
+			} else {
+				tc.Comment = answer
+			}
+		}
+	}
+	return data
+}
+
+// rawString quotes s as a raw string literal unless it contains a backtick
+func rawString(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprintf("`%s`", s)
+}
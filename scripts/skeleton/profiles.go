@@ -0,0 +1,64 @@
+package skeleton
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+// ProfilesTestName is the generated test running a day against the inputs
+// of every profile
+const ProfilesTestName = "profiles_test.go"
+
+// ProfileCase is a known answer for the input of a profile
+type ProfileCase struct {
+	Name string
+	File string
+	Part int
+	Want string
+}
+
+// ProfileCases returns a case per known answer of the profiles, inputs are
+// referenced by their file name and are not required to exist
+func ProfileCases(profiles []aoc.Profile, answers aoc.KnownAnswers) []ProfileCase {
+	cases := []ProfileCase{}
+	for _, profile := range profiles {
+		for index, answer := range answers[profile.Name] {
+			cases = append(cases, ProfileCase{
+				Name: fmt.Sprintf("%s part %d", profile.Name, index+1),
+				File: profile.InputName(),
+				Part: index + 1,
+				Want: answer,
+			})
+		}
+	}
+	return cases
+}
+
+// WriteProfilesTest (re)generates the profiles test in dir, without cases
+// an existing one is removed
+func WriteProfilesTest(dir string, cases []ProfileCase) error {
+	filename := filepath.Join(dir, ProfilesTestName)
+	if len(cases) == 0 {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	ts, err := parseTemplates()
+	if err != nil {
+		return fmt.Errorf("parsing tmpls directory: %w", err)
+	}
+	data := struct {
+		Package string
+		Cases   []ProfileCase
+	}{filepath.Base(dir), cases}
+	content, err := render(ts, "profiles_test.go.tmpl", data)
+	if err != nil {
+		return fmt.Errorf("rendering %s: %w", ProfilesTestName, err)
+	}
+	return os.WriteFile(filename, content, os.FileMode(0644))
+}
@@ -0,0 +1,49 @@
+package skeleton
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+func TestWriteProfilesTest(t *testing.T) {
+	profiles := []aoc.Profile{{Name: "main", Default: true}, {Name: "alt"}, {Name: "bob"}}
+	answers := aoc.KnownAnswers{"main": {"11", "31"}, "alt": {"12"}}
+
+	cases := ProfileCases(profiles, answers)
+	want := []ProfileCase{
+		{"main part 1", "input.txt", 1, "11"},
+		{"main part 2", "input.txt", 2, "31"},
+		{"alt part 1", "input.alt.txt", 1, "12"},
+	}
+	if len(cases) != len(want) {
+		t.Fatalf("ProfileCases() = %+v, want %+v", cases, want)
+	}
+	for index := range want {
+		if cases[index] != want[index] {
+			t.Errorf("case %d = %+v, want %+v", index, cases[index], want[index])
+		}
+	}
+
+	dir := filepath.Join(t.TempDir(), "day01")
+	os.Mkdir(dir, os.ModePerm)
+	if err := WriteProfilesTest(dir, cases); err != nil {
+		t.Fatalf("WriteProfilesTest() error = %v", err)
+	}
+	content, _ := os.ReadFile(filepath.Join(dir, ProfilesTestName))
+	for _, part := range []string{"// Code generated", "package day01\n", "errors.Is(err, inputs.ErrMissing)", "name: \"alt part 1\",\n\t\t\tfile: \"input.alt.txt\",\n\t\t\tpart: 1,\n\t\t\twant: \"12\",\n"} {
+		if !strings.Contains(string(content), part) {
+			t.Errorf("%s is missing %q:\n%s", ProfilesTestName, part, content)
+		}
+	}
+
+	if err := WriteProfilesTest(dir, nil); err != nil {
+		t.Fatalf("WriteProfilesTest() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ProfilesTestName)); !os.IsNotExist(err) {
+		t.Errorf("WriteProfilesTest() without cases kept the file: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package skeleton
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+// Shape is the structure of a puzzle input, the templates pick the
+// parseInput matching it
+type Shape int
+
+const (
+	LINES      Shape = iota // anything else, split into lines
+	GRID                    // lines of characters of equal length
+	SECTIONS                // blocks of lines separated by blank lines
+	COMMA_INTS              // a single line of comma separated ints
+	INT_LINES               // lines of whitespace separated ints
+	RECORDS                 // lines of "key: values"
+)
+
+var shapeNames = map[Shape]string{
+	LINES:      "lines",
+	GRID:       "grid",
+	SECTIONS:   "sections",
+	COMMA_INTS: "comma-ints",
+	INT_LINES:  "int-lines",
+	RECORDS:    "records",
+}
+
+func (s Shape) String() string {
+	return shapeNames[s]
+}
+
+// ParseShape parses the name of a shape as used by the -shape flag
+func ParseShape(name string) (Shape, error) {
+	for shape, shapeName := range shapeNames {
+		if shapeName == name {
+			return shape, nil
+		}
+	}
+	return LINES, fmt.Errorf("unknown input shape %q, use one of lines, grid, sections, comma-ints, int-lines or records", name)
+}
+
+// UsesStrings reports whether the parseInput of the shape needs the strings
+// import
+func (s Shape) UsesStrings() bool {
+	return s != COMMA_INTS
+}
+
+// UsesCast reports whether the parseInput of the shape needs the cast import
+func (s Shape) UsesCast() bool {
+	return s == COMMA_INTS || s == INT_LINES
+}
+
+var (
+	commaIntsRegex = regexp.MustCompile(`^-?\d+(,-?\d+)+$`)
+	intLineRegex   = regexp.MustCompile(`^\s*-?\d+(\s+-?\d+)*\s*$`)
+	recordRegex    = regexp.MustCompile(`^[^:\s][^:]*: \S`)
+	digitsRegex    = regexp.MustCompile(`^\d+$`)
+	// tokens joined by separators, e.g. kh-tc or 5,4, even if all lines have
+	// the same width
+	tokensRegex = regexp.MustCompile(`^[[:alnum:]]+([-,:][[:alnum:]]+)+$`)
+)
+
+// minDigitGrid is the minimal number of rows and columns of a grid of
+// digits, smaller blocks of digits are more likely ints of the same length
+const minDigitGrid = 5
+
+// DetectShape classifies a puzzle input. Lines of digits only are a grid if
+// they are at least minDigitGrid wide and high, like a height map, and ints
+// otherwise.
+func DetectShape(input string) Shape {
+	input = strings.TrimRight(input, "\n")
+	if strings.TrimSpace(input) == "" {
+		return LINES
+	}
+	if strings.Contains(input, "\n\n") {
+		return SECTIONS
+	}
+
+	lines := strings.Split(input, "\n")
+	switch {
+	case len(lines) == 1 && commaIntsRegex.MatchString(input):
+		return COMMA_INTS
+	case isGrid(lines):
+		return GRID
+	case all(lines, recordRegex):
+		return RECORDS
+	case all(lines, intLineRegex):
+		return INT_LINES
+	}
+	return LINES
+}
+
+func isGrid(lines []string) bool {
+	width := len(lines[0])
+	if len(lines) < 2 || width < 2 {
+		return false
+	}
+	for _, line := range lines {
+		if len(line) != width || strings.ContainsAny(line, " \t") {
+			return false
+		}
+	}
+	if all(lines, tokensRegex) {
+		return false
+	}
+	return !all(lines, digitsRegex) || (width >= minDigitGrid && len(lines) >= minDigitGrid)
+}
+
+func all(lines []string, regex *regexp.Regexp) bool {
+	for _, line := range lines {
+		if !regex.MatchString(line) {
+			return false
+		}
+	}
+	return true
+}
+
+// ShapeOf detects the shape of the input file, without an input the first
+// example of the puzzle is classified instead
+func ShapeOf(inputFilename string, examples []aoc.PartExamples) Shape {
+	if content, err := os.ReadFile(inputFilename); err == nil && strings.TrimSpace(string(content)) != "" {
+		return DetectShape(string(content))
+	}
+	if len(examples) > 0 {
+		if input, ok := examples[0].Input(); ok {
+			return DetectShape(input)
+		}
+	}
+	return LINES
+}
@@ -0,0 +1,131 @@
+package skeleton
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+func TestDetectShape(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Shape
+	}{
+		{"empty", "", LINES},
+		{"grid", "....#\n.#...\n..^..\n", GRID},
+		{"digit grid", "89010123\n78121874\n87430965\n96549874\n45678903\n32019012\n01329801\n10456732", GRID},
+		{"pipe grid", "..F7.\n.FJ|.\nSJ.L7\n|F--J\nLJ...", GRID},
+		{"sections", "47|53\n97|13\n\n75,47,61\n97,61", SECTIONS},
+		{"comma ints", "1,9,10,3,2,3,11,0,99,30,40,50\n", COMMA_INTS},
+		{"negative comma ints", "3,-1,4", COMMA_INTS},
+		{"int lines", "3   4\n4   3\n2   5", INT_LINES},
+		{"single ints", "199\n200\n208\n210", INT_LINES},
+		{"single ints of the same width", "199\n200\n208", INT_LINES},
+		{"records", "190: 10 19\n3267: 81 40 27", RECORDS},
+		{"named records", "Game 1: 3 blue, 4 red\nGame 2: 1 green", RECORDS},
+		{"lines", "p=0,4 v=3,-3\np=6,3 v=-1,-3", LINES},
+		{"connections", "kh-tc\nqp-kh\nde-cg\nka-co\nyn-aq\nqp-ub\ncg-tb", LINES},
+		{"comma int lines", "5,4\n4,12\n20,5", LINES},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectShape(tt.input); got != tt.want {
+				t.Errorf("DetectShape() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseShape(t *testing.T) {
+	for shape, name := range shapeNames {
+		if got, err := ParseShape(name); err != nil || got != shape {
+			t.Errorf("ParseShape(%q) = %s, %v, want %s", name, got, err, shape)
+		}
+	}
+	if _, err := ParseShape("graph"); err == nil {
+		t.Errorf("ParseShape(%q) found a shape", "graph")
+	}
+}
+
+func TestShapeOf(t *testing.T) {
+	dir := t.TempDir()
+	examples := []aoc.PartExamples{{Inputs: []string{"1,2,3"}}}
+
+	missing := filepath.Join(dir, "input.txt")
+	if got := ShapeOf(missing, examples); got != COMMA_INTS {
+		t.Errorf("ShapeOf() without input = %s, want the shape of the example", got)
+	}
+	if got := ShapeOf(missing, nil); got != LINES {
+		t.Errorf("ShapeOf() without input and example = %s, want lines", got)
+	}
+
+	os.WriteFile(missing, []byte("#.\n.#\n"), 0644)
+	if got := ShapeOf(missing, examples); got != GRID {
+		t.Errorf("ShapeOf() = %s, want the shape of the input", got)
+	}
+}
+
+func TestRenderShapes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the rendered packages")
+	}
+	ts, err := parseTemplates()
+	if err != nil {
+		t.Fatalf("parseTemplates() error = %v", err)
+	}
+
+	// the packages have to live inside the module to import cast and solution,
+	// there is no input.txt which the tests have to build without
+	dir, err := os.MkdirTemp(".", "shapes-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	packages := []string{}
+	for shape := range shapeNames {
+		data := newTemplateData(nil, IntAnswers)
+		data.Year, data.Day, data.Package, data.Shape = 2024, int(shape)+1, PackageName(int(shape)+1), shape
+		pkg := filepath.Join(dir, shape.String())
+		os.Mkdir(pkg, os.ModePerm)
+		for _, name := range []string{"main.go", "main_test.go"} {
+			out, err := render(ts, name+".tmpl", data)
+			if err != nil {
+				t.Fatalf("%s: render() error = %v", shape, err)
+			}
+			if err := os.WriteFile(filepath.Join(pkg, name), out, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		packages = append(packages, "./"+pkg)
+	}
+
+	out, err := exec.Command("go", append([]string{"vet"}, packages...)...).CombinedOutput()
+	if err != nil {
+		t.Errorf("go vet of the rendered shapes failed: %v\n%s", err, out)
+	}
+
+	// the example case of every answer type has to pass with the skeleton
+	types, _ := ParseAnswerTypes("string,big")
+	data := newTemplateData(nil, types)
+	data.Year, data.Day, data.Package = 2024, 1, PackageName(1)
+	pkg := filepath.Join(dir, "typed")
+	os.Mkdir(pkg, os.ModePerm)
+	for _, name := range []string{"main.go", "main_test.go"} {
+		out, err := render(ts, name+".tmpl", data)
+		if err != nil {
+			t.Fatalf("typed: render() error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(pkg, name), out, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out, err = exec.Command("go", "test", "./"+pkg).CombinedOutput()
+	if err != nil {
+		t.Errorf("go test of the rendered answer types failed: %v\n%s", err, out)
+	}
+}
@@ -2,57 +2,102 @@
 package skeleton
 
 import (
+	"bytes"
 	"embed"
 	"fmt"
+	"go/format"
 	"log"
 	"os"
 	"path/filepath"
 	"text/template"
 
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
 	"github.com/zMoooooritz/advent-of-code/util"
 )
 
-//go:embed tmpls/*.go
+//go:embed tmpls/*.tmpl
 var fs embed.FS
 
-// Run makes a skeleton main.go and main_test.go file for the given day and year
-func Run(day, year int) {
-	if day > 25 || day <= 0 {
-		log.Fatalf("invalid -day value, must be 1 through 25, got %v", day)
-	}
+// Options of a generated skeleton, the zero value makes the default
+// skeleton without examples
+type Options struct {
+	Title    string             // puzzle title, empty if the page was not fetched
+	Examples []aoc.PartExamples // examples of the unlocked parts
+	Types    [2]AnswerType      // answer types of the parts, IntAnswers if unset
+	Shape    Shape              // shape of the input parseInput is made for
+	Template string             // user template set, empty for the default one
+}
 
+// Run makes a skeleton main.go and main_test.go file for the given day and
+// year, the examples of the unlocked parts are written into the test tables
+// and the parts return the given answer types
+func Run(day, year int, opts Options) {
 	if year < 2015 {
 		log.Fatalf("year is before 2015: %d", year)
 	}
 
-	ts, err := template.ParseFS(fs, "tmpls/*.go")
-	if err != nil {
-		log.Fatalf("parsing tmpls directory: %s", err)
+	if day > aoc.DaysIn(year) || day <= 0 {
+		log.Fatalf("invalid -day value, must be 1 through %d, got %v", aoc.DaysIn(year), day)
 	}
 
-	mainFilename := filepath.Join(util.Dirname(), "../../", fmt.Sprintf("%d/day%02d/main.go", year, day))
-	testFilename := filepath.Join(util.Dirname(), "../../", fmt.Sprintf("%d/day%02d/main_test.go", year, day))
-
-	err = os.MkdirAll(filepath.Dir(mainFilename), os.ModePerm)
+	root := filepath.Join(util.Dirname(), "../../")
+	ts, err := loadTemplates(root, opts.Template, year)
 	if err != nil {
-		log.Fatalf("making directory: %s", err)
+		log.Fatalf("loading templates: %s", err)
 	}
 
+	mainFilename := filepath.Join(root, fmt.Sprintf("%d/day%02d/main.go", year, day))
+	testFilename := filepath.Join(root, fmt.Sprintf("%d/day%02d/main_test.go", year, day))
+
 	ensureNotOverwriting(mainFilename)
 	ensureNotOverwriting(testFilename)
 
-	mainFile, err := os.Create(mainFilename)
+	if opts.Types == [2]AnswerType{} {
+		opts.Types = IntAnswers
+	}
+	data := newTemplateData(opts.Examples, opts.Types)
+	data.Year, data.Day, data.Package = year, day, PackageName(day)
+	data.Title, data.Shape = opts.Title, opts.Shape
+	main, err := render(ts, "main.go.tmpl", data)
 	if err != nil {
-		log.Fatalf("creating main.go file: %v", err)
+		log.Fatalf("rendering main.go: %v", err)
 	}
-	testFile, err := os.Create(testFilename)
+	test, err := render(ts, "main_test.go.tmpl", data)
 	if err != nil {
-		log.Fatalf("creating main_test.go file: %v", err)
+		log.Fatalf("rendering main_test.go: %v", err)
 	}
 
-	ts.ExecuteTemplate(mainFile, "main.go", nil)
-	ts.ExecuteTemplate(testFile, "main_test.go", nil)
+	if err := aoc.WriteToFile(mainFilename, main); err != nil {
+		log.Fatalf("writing main.go: %v", err)
+	}
+	if err := aoc.WriteToFile(testFilename, test); err != nil {
+		log.Fatalf("writing main_test.go: %v", err)
+	}
 	fmt.Printf("templates made for %d-day%d\n", year, day)
+
+	if err := WriteDays(root); err != nil {
+		log.Fatalf("registering day: %v", err)
+	}
+}
+
+// PackageName returns the name of the package of a day, the directory name
+func PackageName(day int) string {
+	return fmt.Sprintf("day%02d", day)
+}
+
+func parseTemplates() (*template.Template, error) {
+	return template.New("").Funcs(template.FuncMap{"rawString": rawString}).ParseFS(fs, "tmpls/*.tmpl")
+}
+
+// render executes the named template and gofmts the result, the examples
+// can make the alignment of the test tables change and user templates need
+// not be formatted
+func render(ts *template.Template, name string, data any) ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := ts.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
 }
 
 func ensureNotOverwriting(filename string) {
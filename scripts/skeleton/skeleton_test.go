@@ -0,0 +1,169 @@
+package skeleton
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+func renderTest(t *testing.T, parts []aoc.PartExamples) string {
+	t.Helper()
+	return renderTyped(t, "main_test.go.tmpl", parts, IntAnswers)
+}
+
+func renderTyped(t *testing.T, name string, parts []aoc.PartExamples, types [2]AnswerType) string {
+	t.Helper()
+	ts, err := parseTemplates()
+	if err != nil {
+		t.Fatalf("parseTemplates() error = %v", err)
+	}
+	data := newTemplateData(parts, types)
+	data.Year, data.Day, data.Package = 2024, 1, PackageName(1)
+	out, err := render(ts, name, data)
+	if err != nil {
+		t.Fatalf("render() error = %v", err)
+	}
+	return string(out)
+}
+
+func TestRenderExamples(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []aoc.PartExamples
+		want  []string
+	}{
+		{
+			name:  "no examples",
+			parts: nil,
+			want:  []string{"var example = ``\n", "input: example,\n\t\t\twant:  0,\n"},
+		},
+		{
+			name: "part 1 unlocked",
+			parts: []aoc.PartExamples{
+				{Inputs: []string{"3   4\n4   3", "3 4"}, Answers: []string{"2", "11"}},
+			},
+			want: []string{"var example = `3   4\n4   3`\n", "want:  11,\n", "want:  0,\n"},
+		},
+		{
+			name: "both parts unlocked",
+			parts: []aoc.PartExamples{
+				{Inputs: []string{"1\n2"}, Answers: []string{"3"}},
+				{Inputs: []string{"4`5"}, Answers: []string{"a,b"}},
+			},
+			want: []string{
+				"var example = `1\n2`\nvar example2 = \"4`5\"\n",
+				"input: example,\n\t\t\twant:  3,\n",
+				"input: example2,\n\t\t\twant:  0, // example answer: a,b\n",
+			},
+		},
+		{
+			name: "part 2 reuses the example",
+			parts: []aoc.PartExamples{
+				{Inputs: []string{"1\n2"}, Answers: []string{"3"}},
+				{Answers: []string{"7", "42"}},
+			},
+			want: []string{"input: example,\n\t\t\twant:  3,\n", "input: example,\n\t\t\twant:  42,\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderTest(t, tt.parts)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("rendered main_test.go is missing %q:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderAnswerTypes(t *testing.T) {
+	parts := []aoc.PartExamples{
+		{Inputs: []string{"1\n2"}, Answers: []string{"4,6,3"}},
+		{Answers: []string{"123456789012345678901"}},
+	}
+	tests := []struct {
+		name  string
+		types string
+		file  string
+		want  []string
+	}{
+		{
+			name:  "int main.go",
+			types: "int",
+			file:  "main.go.tmpl",
+			want:  []string{"import (\n\t\"strings\"\n", "func part1(input string) int {", "\treturn 0\n"},
+		},
+		{
+			name:  "string and big main.go",
+			types: "string,big",
+			file:  "main.go.tmpl",
+			want: []string{
+				"import (\n\t\"math/big\"\n\t\"strings\"\n",
+				"func part1(input string) string {",
+				"\treturn \"\"\n",
+				"func part2(input string) *big.Int {\n\treturn new(big.Int)\n",
+			},
+		},
+		{
+			name:  "string and big main_test.go",
+			types: "string,big",
+			file:  "main_test.go.tmpl",
+			want: []string{
+				"want  string\n",
+				"want:  \"4,6,3\",\n",
+				"// \twant:  \"\",\n",
+				"want:  \"123456789012345678901\",\n",
+				"if got := part1(tt.input); got != tt.want {",
+				"if got := part2(tt.input); got.String() != tt.want {",
+			},
+		},
+		{
+			name:  "answer not fitting int64",
+			types: "int64",
+			file:  "main_test.go.tmpl",
+			want:  []string{"want  int64\n", "want:  0, // example answer: 4,6,3\n", "want:  0, // example answer: 123456789012345678901\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			types, err := ParseAnswerTypes(tt.types)
+			if err != nil {
+				t.Fatalf("ParseAnswerTypes() error = %v", err)
+			}
+			got := renderTyped(t, tt.file, parts, types)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("rendered %s is missing %q:\n%s", tt.file, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseAnswerTypes(t *testing.T) {
+	tests := []struct {
+		flag    string
+		want    [2]string
+		wantErr bool
+	}{
+		{"int", [2]string{"int", "int"}, false},
+		{"string", [2]string{"string", "string"}, false},
+		{"string,int", [2]string{"string", "int"}, false},
+		{"int64, big", [2]string{"int64", "big"}, false},
+		{"float", [2]string{"int", "int"}, true},
+		{"int,int,int", [2]string{"int", "int"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.flag, func(t *testing.T) {
+			got, err := ParseAnswerTypes(tt.flag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAnswerTypes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if names := [2]string{got[0].Name, got[1].Name}; names != tt.want {
+				t.Errorf("ParseAnswerTypes() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
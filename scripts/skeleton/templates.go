@@ -0,0 +1,50 @@
+package skeleton
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/template"
+)
+
+// TemplatesDir is the directory below the repo root holding the user
+// template sets and the per-year overrides
+const TemplatesDir = "templates"
+
+// loadTemplates returns the embedded templates overlaid by the user ones in
+// order of precedence: templates/<set>, templates/<year> and
+// templates/<year>/<set>. An overlay replaces a whole template file or only
+// the blocks it defines, e.g. parseInput.
+func loadTemplates(root, set string, year int) (*template.Template, error) {
+	ts, err := parseTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("parsing embedded templates: %w", err)
+	}
+
+	dir := filepath.Join(root, TemplatesDir)
+	overlays := []string{strconv.Itoa(year)}
+	if set != "" {
+		if _, err := strconv.Atoi(set); err == nil {
+			return nil, fmt.Errorf("template set %q is a year, years are overrides", set)
+		}
+		if info, err := os.Stat(filepath.Join(dir, set)); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("unknown template set %q, no directory %s", set, filepath.Join(dir, set))
+		}
+		overlays = []string{set, strconv.Itoa(year), filepath.Join(strconv.Itoa(year), set)}
+	}
+
+	for _, overlay := range overlays {
+		files, err := filepath.Glob(filepath.Join(dir, overlay, "*.tmpl"))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			continue
+		}
+		if ts, err = ts.ParseFiles(files...); err != nil {
+			return nil, fmt.Errorf("parsing templates of %s: %w", overlay, err)
+		}
+	}
+	return ts, nil
+}
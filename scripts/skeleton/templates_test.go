@@ -0,0 +1,98 @@
+package skeleton
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const gridParseInput = `{{define "parseInput"}}
+func parseInput(input string) (grid [][]rune) {
+	for _, line := range strings.Split(input, "\n") {
+		grid = append(grid, []rune(line))
+	}
+	return grid
+}
+{{- end}}`
+
+const yearMain = `package {{.Package}}
+
+// {{.Year}} override
+`
+
+func TestLoadTemplates(t *testing.T) {
+	root := t.TempDir()
+	for filename, content := range map[string]string{
+		"grid/parse.tmpl":        gridParseInput,
+		"2020/main.go.tmpl":      yearMain,
+		"2021/grid/main.go.tmpl": yearMain,
+		"empty/.keep":            "",
+	} {
+		filename = filepath.Join(root, TemplatesDir, filename)
+		os.MkdirAll(filepath.Dir(filename), os.ModePerm)
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		set     string
+		year    int
+		want    string
+		wantErr bool
+	}{
+		{name: "embedded", year: 2024, want: "func parseInput(input string) (ans []string) {"},
+		{name: "set block", set: "grid", year: 2024, want: "func parseInput(input string) (grid [][]rune) {"},
+		{name: "set without templates", set: "empty", year: 2024, want: "func parseInput(input string) (ans []string) {"},
+		{name: "year override", year: 2020, want: "// 2020 override"},
+		{name: "year override of a set", set: "grid", year: 2020, want: "// 2020 override"},
+		{name: "year set override", set: "grid", year: 2021, want: "// 2021 override"},
+		{name: "year set override of another set", year: 2021, want: "func parseInput(input string) (ans []string) {"},
+		{name: "unknown set", set: "graph", year: 2024, wantErr: true},
+		{name: "year as set", set: "2020", year: 2024, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := loadTemplates(root, tt.set, tt.year)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadTemplates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			data := newTemplateData(nil, IntAnswers)
+			data.Year, data.Day, data.Package = tt.year, 1, PackageName(1)
+			out, err := render(ts, "main.go.tmpl", data)
+			if err != nil {
+				t.Fatalf("render() error = %v", err)
+			}
+			if !strings.Contains(string(out), tt.want) {
+				t.Errorf("rendered main.go is missing %q:\n%s", tt.want, out)
+			}
+		})
+	}
+}
+
+func TestRenderTitle(t *testing.T) {
+	ts, err := loadTemplates("../..", "intcode", 2019)
+	if err != nil {
+		t.Fatalf("loadTemplates() error = %v", err)
+	}
+	data := newTemplateData(nil, IntAnswers)
+	data.Year, data.Day, data.Package, data.Title = 2019, 2, PackageName(2), "1202 Program Alarm"
+	out, err := render(ts, "main.go.tmpl", data)
+	if err != nil {
+		t.Fatalf("render() error = %v", err)
+	}
+	for _, want := range []string{
+		"// Package day02 solves 2019 day 2: 1202 Program Alarm\npackage day02\n",
+		"\t\"github.com/zMoooooritz/advent-of-code/intcode\"\n",
+		"return intcode.ParseProgram(input)",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("rendered main.go is missing %q:\n%s", want, out)
+		}
+	}
+}
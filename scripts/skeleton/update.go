@@ -0,0 +1,121 @@
+package skeleton
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+var (
+	emptyExample    = "var example = ``\n"
+	exampleVar      = regexp.MustCompile("var example = (`[^`]*`|\"(?:[^\"\\\\]|\\\\.)*\")\n")
+	zeroExample     = regexp.MustCompile(`(name:  "example",\n\t+input: )(\w+)(,\n\t+want:  )(?:0|""|"0"),`)
+	zeroActual      = regexp.MustCompile(`(name:  "actual",\n\t+input: input,\n\t+want:  )(?:0|""|"0"),`)
+	commentedActual = regexp.MustCompile(`\t*// \{\n\t*// \tname:  "actual",\n\t*// \tinput: input,\n\t*// \twant:  (?:0|""|"0"),\n\t*// \},\n`)
+	wantField       = regexp.MustCompile(`\n\t+want +(\w+)\n`)
+)
+
+// UpdateTests fills in the cases of a generated main_test.go that became known
+// after the skeleton was made: the examples of newly unlocked parts and the
+// accepted answers as actual cases. Cases that no longer look like the
+// template are left alone. It returns a description of every change.
+func UpdateTests(filename string, answers []string, examples []aoc.PartExamples) ([]string, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	src := string(content)
+	changes := []string{}
+	data := newTemplateData(examples, tableTypes(src))
+
+	if data.Examples[0].Input != "" && strings.Contains(src, emptyExample) {
+		src = strings.Replace(src, emptyExample, fmt.Sprintf("var example = %s\n", rawString(data.Examples[0].Input)), 1)
+		changes = append(changes, "filled example input")
+	}
+	if len(data.Examples) > 1 && !strings.Contains(src, "var example2 = ") {
+		if loc := exampleVar.FindStringIndex(src); loc != nil {
+			src = src[:loc[1]] + fmt.Sprintf("var example2 = %s\n", rawString(data.Examples[1].Input)) + src[loc[1]:]
+			changes = append(changes, "added example2 input")
+		}
+	}
+
+	for index, tc := range []testCase{data.Part1, data.Part2} {
+		part := index + 1
+		start, end, ok := testFunc(src, part)
+		if !ok {
+			continue
+		}
+		body := src[start:end]
+
+		if index < len(examples) {
+			if match := zeroExample.FindStringSubmatch(body); match != nil && (match[2] != tc.Input || tc.Want != tc.Type.WantZero()) {
+				body = strings.Replace(body, match[0], match[1]+tc.Input+match[3]+tc.Want+",", 1)
+				changes = append(changes, fmt.Sprintf("part %d: example input %s, want %s", part, tc.Input, tc.Want))
+			}
+		}
+
+		if index < len(answers) {
+			answer := answers[index]
+			literal, ok := tc.Type.literal(answer)
+			actual := fmt.Sprintf("\t\t{\n\t\t\tname:  \"actual\",\n\t\t\tinput: input,\n\t\t\twant:  %s,\n\t\t},\n", literal)
+			switch {
+			case !ok && (commentedActual.MatchString(body) || zeroActual.MatchString(body)):
+				changes = append(changes, fmt.Sprintf("part %d: answer %q is no %s, fill in the actual case by hand", part, answer, tc.Type.Name))
+			case commentedActual.MatchString(body):
+				body = commentedActual.ReplaceAllLiteralString(body, actual)
+				changes = append(changes, fmt.Sprintf("part %d: actual want %s", part, literal))
+			case zeroActual.MatchString(body):
+				body = zeroActual.ReplaceAllLiteralString(body, zeroActual.FindStringSubmatch(body)[1]+literal+",")
+				changes = append(changes, fmt.Sprintf("part %d: actual want %s", part, literal))
+			}
+		}
+
+		src = src[:start] + body + src[end:]
+	}
+
+	if len(changes) == 0 {
+		return changes, nil
+	}
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", filename, err)
+	}
+	return changes, os.WriteFile(filename, formatted, os.FileMode(0644))
+}
+
+// tableTypes returns the answer types of the test tables, tables of unknown
+// types are treated as int
+func tableTypes(src string) [2]AnswerType {
+	types := IntAnswers
+	for index := range types {
+		start, end, ok := testFunc(src, index+1)
+		if !ok {
+			continue
+		}
+		body := src[start:end]
+		if match := wantField.FindStringSubmatch(body); match != nil {
+			if t, ok := tableType(match[1], body); ok {
+				types[index] = t
+			}
+		}
+	}
+	return types
+}
+
+// testFunc returns the range of the Test_partN function within src
+func testFunc(src string, part int) (start, end int, ok bool) {
+	start = strings.Index(src, fmt.Sprintf("func Test_part%d(", part))
+	if start < 0 {
+		return 0, 0, false
+	}
+	end = strings.Index(src[start+1:], "\nfunc ")
+	if end < 0 {
+		return start, len(src), true
+	}
+	return start, start + 1 + end, true
+}
@@ -0,0 +1,102 @@
+package skeleton
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+)
+
+func TestUpdateTests(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "main_test.go")
+	if err := os.WriteFile(filename, []byte(renderTest(t, nil)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		name     string
+		answers  []string
+		examples []aoc.PartExamples
+		changes  int
+		want     []string
+	}{
+		{
+			name:     "part one unlocked",
+			examples: []aoc.PartExamples{{Inputs: []string{"1\n2"}, Answers: []string{"3"}}},
+			changes:  2,
+			want:     []string{"var example = `1\n2`\n", "input: example,\n\t\t\twant:  3,\n", "// \tname:  \"actual\","},
+		},
+		{
+			name:    "part one solved",
+			answers: []string{"1234"},
+			examples: []aoc.PartExamples{
+				{Inputs: []string{"1\n2"}, Answers: []string{"3"}},
+				{Inputs: []string{"5"}, Answers: []string{"10"}},
+			},
+			changes: 3,
+			want: []string{
+				"var example = `1\n2`\nvar example2 = `5`\n",
+				"name:  \"actual\",\n\t\t\tinput: input,\n\t\t\twant:  1234,\n",
+				"input: example2,\n\t\t\twant:  10,\n",
+			},
+		},
+		{
+			name:    "part two solved",
+			answers: []string{"1234", "abc"},
+			examples: []aoc.PartExamples{
+				{Inputs: []string{"1\n2"}, Answers: []string{"3"}},
+				{Inputs: []string{"5"}, Answers: []string{"10"}},
+			},
+			changes: 1,
+			want:    []string{"want:  1234,\n", "// \tname:  \"actual\","},
+		},
+	}
+	for _, step := range steps {
+		changes, err := UpdateTests(filename, step.answers, step.examples)
+		if err != nil {
+			t.Fatalf("%s: UpdateTests() error = %v", step.name, err)
+		}
+		if len(changes) != step.changes {
+			t.Errorf("%s: UpdateTests() = %q, want %d changes", step.name, changes, step.changes)
+		}
+		content, _ := os.ReadFile(filename)
+		for _, want := range step.want {
+			if !strings.Contains(string(content), want) {
+				t.Errorf("%s: main_test.go is missing %q:\n%s", step.name, want, content)
+			}
+		}
+	}
+}
+
+func TestUpdateTypedTests(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "main_test.go")
+	types, _ := ParseAnswerTypes("string,big")
+	if err := os.WriteFile(filename, []byte(renderTyped(t, "main_test.go.tmpl", nil, types)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	examples := []aoc.PartExamples{
+		{Inputs: []string{"1\n2"}, Answers: []string{"4,6,3"}},
+		{Answers: []string{"117440"}},
+	}
+	changes, err := UpdateTests(filename, []string{"co,de,ka", "not a number"}, examples)
+	if err != nil {
+		t.Fatalf("UpdateTests() error = %v", err)
+	}
+	if len(changes) != 5 {
+		t.Errorf("UpdateTests() = %q, want 5 changes", changes)
+	}
+	content, _ := os.ReadFile(filename)
+	for _, want := range []string{
+		"input: example,\n\t\t\twant:  \"4,6,3\",\n",
+		"name:  \"actual\",\n\t\t\tinput: input,\n\t\t\twant:  \"co,de,ka\",\n",
+		"input: example,\n\t\t\twant:  \"117440\",\n",
+		"// \twant:  \"0\",\n",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("main_test.go is missing %q:\n%s", want, content)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// Package status summarizes how far the days of this repo are solved.
+package status
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/aoc"
+	"github.com/zMoooooritz/advent-of-code/scripts/runner"
+)
+
+// Part is the state of one part of a day
+type Part struct {
+	// Stub is set if the part still returns the template zero
+	Stub bool
+	// Tested is set if the test file has an actual case with a known answer
+	Tested bool
+	// Accepted is the answer accepted according to the submission ledger
+	Accepted string
+	// Star is set if the ledger or the calendar page shows the star
+	Star bool
+}
+
+// Day is the state of a single day, Exists is false if there is no skeleton
+type Day struct {
+	Year   int
+	Day    int
+	Exists bool
+	Parts  [2]Part
+}
+
+// Year holds all 25 days of a year
+type Year struct {
+	Year int
+	Days [25]Day
+}
+
+// Scan inspects every year below root that contains at least one solution
+func Scan(root string) ([]Year, error) {
+	solutions, err := runner.DayDirs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	years := []Year{}
+	for _, solution := range solutions {
+		if len(years) == 0 || years[len(years)-1].Year != solution.Year {
+			year := Year{Year: solution.Year}
+			for index := range year.Days {
+				year.Days[index] = Day{Year: solution.Year, Day: index + 1}
+			}
+			years = append(years, year)
+		}
+
+		day, err := scanDay(solution)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", solution, err)
+		}
+		years[len(years)-1].Days[solution.Day-1] = day
+	}
+	return years, nil
+}
+
+func scanDay(solution runner.Solution) (Day, error) {
+	day := Day{Year: solution.Year, Day: solution.Day, Exists: true}
+
+	fset := token.NewFileSet()
+	mainFile, err := parser.ParseFile(fset, filepath.Join(solution.Dir, "main.go"), nil, 0)
+	if err != nil {
+		return day, err
+	}
+	testFile, err := parser.ParseFile(fset, filepath.Join(solution.Dir, "main_test.go"), nil, 0)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return day, err
+	}
+
+	ledger, err := aoc.LoadLedger(filepath.Join(solution.Dir, aoc.LedgerName))
+	if err != nil {
+		return day, err
+	}
+
+	for index := range day.Parts {
+		part := &day.Parts[index]
+		part.Stub = isStub(findFunc(mainFile, fmt.Sprintf("part%d", index+1)))
+		if testFile != nil {
+			part.Tested = hasActualCase(findFunc(testFile, fmt.Sprintf("Test_part%d", index+1)))
+		}
+		part.Accepted, part.Star = ledger.Accepted(index + 1)
+	}
+	return day, nil
+}
+
+// AddCalendar marks the stars shown on the calendar page of the year
+func (y *Year) AddCalendar(stars map[int]int) {
+	for index := range y.Days {
+		for part := range y.Days[index].Parts {
+			if stars[index+1] > part {
+				y.Days[index].Parts[part].Star = true
+			}
+		}
+	}
+}
+
+func findFunc(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+// isStub reports whether every return of the function returns the zero
+// value of its answer type like the skeleton template does
+func isStub(fn *ast.FuncDecl) bool {
+	if fn == nil || fn.Body == nil {
+		return false
+	}
+	stub := true
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) != 1 || !isZero(n.Results[0]) {
+				stub = false
+			}
+		}
+		return stub
+	})
+	return stub
+}
+
+// hasActualCase reports whether the test table contains a case named actual
+// with a want other than the template zero, commented out cases are ignored
+func hasActualCase(fn *ast.FuncDecl) bool {
+	if fn == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(fn, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || found {
+			return !found
+		}
+		fields := map[string]ast.Expr{}
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok {
+					fields[key.Name] = kv.Value
+				}
+			}
+		}
+		name, ok := fields["name"].(*ast.BasicLit)
+		want, hasWant := fields["want"]
+		if ok && name.Value == `"actual"` && hasWant && !isZero(want) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// isZero reports whether expr is 0, "", "0" or new(big.Int), the zero
+// answers of the skeleton and the wants of their tests
+func isZero(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		return (expr.Kind == token.INT && expr.Value == "0") || (expr.Kind == token.STRING && (expr.Value == `""` || expr.Value == "``" || expr.Value == `"0"`))
+	case *ast.CallExpr:
+		fun, ok := expr.Fun.(*ast.Ident)
+		if !ok || fun.Name != "new" || len(expr.Args) != 1 {
+			return false
+		}
+		sel, ok := expr.Args[0].(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		return ok && pkg.Name == "big" && sel.Sel.Name == "Int"
+	}
+	return false
+}
+
+// Symbol returns the grid cell of a part
+func (d Day) Symbol(part int) string {
+	p := d.Parts[part]
+	switch {
+	case !d.Exists:
+		return "."
+	case p.Stub:
+		return "0"
+	case p.Star && p.Tested:
+		return "*"
+	case p.Star:
+		return "s"
+	case p.Tested:
+		return "t"
+	default:
+		return "~"
+	}
+}
+
+const legend = "* star and actual test  s star only  t actual test only  ~ implemented  0 returns the template zero  . no skeleton"
+
+// WriteGrid prints one row per part with a column per day, followed by the
+// totals of the year
+func WriteGrid(w io.Writer, years []Year) {
+	for _, year := range years {
+		header := []string{fmt.Sprintf("%-6d", year.Year)}
+		for day := 1; day <= 25; day++ {
+			header = append(header, fmt.Sprintf("%2d", day))
+		}
+		fmt.Fprintln(w, strings.Join(header, " "))
+
+		stars, tested, stubs := 0, 0, 0
+		for part := range 2 {
+			row := []string{fmt.Sprintf("part %d", part+1)}
+			for _, day := range year.Days {
+				row = append(row, fmt.Sprintf("%2s", day.Symbol(part)))
+				p := day.Parts[part]
+				if p.Star {
+					stars++
+				}
+				if p.Tested {
+					tested++
+				}
+				if day.Exists && p.Stub {
+					stubs++
+				}
+			}
+			fmt.Fprintln(w, strings.Join(row, " "))
+		}
+		fmt.Fprintf(w, "stars: %d/50  tested: %d  stubs: %d\n\n", stars, tested, stubs)
+	}
+	fmt.Fprintln(w, legend)
+}
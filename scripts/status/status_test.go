@@ -0,0 +1,177 @@
+package status_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/scripts/status"
+)
+
+const solvedMain = `package main
+
+func part1(input string) int {
+	if input == "" {
+		return 0
+	}
+	return len(input)
+}
+
+func part2(input string) int {
+	parsed := parseInput(input)
+	_ = parsed
+
+	return 0
+}
+`
+
+const typedStubMain = `package main
+
+import "math/big"
+
+func part1(input string) string {
+	return ""
+}
+
+func part2(input string) *big.Int {
+	return new(big.Int)
+}
+`
+
+const callsMain = `package main
+
+func part1(input string) *int {
+	return new(int)
+}
+
+func part2(input string) int64 {
+	return int64(len(input))
+}
+`
+
+const solvedTest = `package main
+
+import "testing"
+
+func Test_part1(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name:  "actual",
+			input: input,
+			want:  42,
+		},
+	}
+	_ = tests
+}
+
+func Test_part2(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name:  "actual",
+			input: input,
+			want:  0,
+		},
+	}
+	_ = tests
+}
+`
+
+// the big placeholder want of the skeleton does not count as tested
+const typedStubTest = `package main
+
+import "testing"
+
+func Test_part2(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "actual",
+			input: input,
+			want:  "0",
+		},
+	}
+	_ = tests
+}
+`
+
+const ledger = `{"submissions": [{"level": 1, "answer": "42", "verdict": "correct", "time": "2024-12-01T05:10:00Z"}]}`
+
+func writeFile(t *testing.T, filename, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filename), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "2024/day01/main.go"), solvedMain)
+	writeFile(t, filepath.Join(root, "2024/day01/main_test.go"), solvedTest)
+	writeFile(t, filepath.Join(root, "2024/day01/submissions.json"), ledger)
+	writeFile(t, filepath.Join(root, "2024/day03/main.go"), solvedMain)
+	writeFile(t, filepath.Join(root, "2024/day04/main.go"), typedStubMain)
+	writeFile(t, filepath.Join(root, "2024/day04/main_test.go"), typedStubTest)
+	writeFile(t, filepath.Join(root, "2024/day05/main.go"), callsMain)
+
+	years, err := status.Scan(root)
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if len(years) != 1 || years[0].Year != 2024 {
+		t.Fatalf("Scan() = %+v, want only 2024", years)
+	}
+	year := years[0]
+
+	day1 := year.Days[0]
+	want := [2]status.Part{
+		{Tested: true, Accepted: "42", Star: true},
+		{Stub: true},
+	}
+	if day1.Parts != want {
+		t.Errorf("day 1 parts = %+v, want %+v", day1.Parts, want)
+	}
+
+	if day4 := year.Days[3]; day4.Parts != [2]status.Part{{Stub: true}, {Stub: true}} {
+		t.Errorf("day 4 parts = %+v, want two stubs", day4.Parts)
+	}
+	if day5 := year.Days[4]; day5.Parts != [2]status.Part{} {
+		t.Errorf("day 5 parts = %+v, want no stubs", day5.Parts)
+	}
+
+	year.AddCalendar(map[int]int{1: 1, 3: 2})
+	tests := []struct {
+		day  int
+		want string
+	}{
+		{1, "*0"},
+		{2, ".."},
+		{3, "s0"},
+	}
+	for _, tt := range tests {
+		day := year.Days[tt.day-1]
+		if got := day.Symbol(0) + day.Symbol(1); got != tt.want {
+			t.Errorf("day %d symbols = %q, want %q", tt.day, got, tt.want)
+		}
+	}
+
+	builder := strings.Builder{}
+	status.WriteGrid(&builder, []status.Year{year})
+	if !strings.Contains(builder.String(), "part 1  *  .  s  0") || !strings.Contains(builder.String(), "stars: 3/50  tested: 1  stubs: 4") {
+		t.Errorf("WriteGrid() =\n%s", builder.String())
+	}
+}
@@ -0,0 +1,45 @@
+package solution
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Format returns the text to submit for an answer. Integers of any size,
+// *big.Int, strings and fmt.Stringers are answers, anything else is most
+// likely a mistake in the solution and returns an error just like an empty
+// or multi-line string does.
+func Format(answer any) (string, error) {
+	var text string
+	switch a := answer.(type) {
+	case int:
+		text = strconv.Itoa(a)
+	case int8, int16, int32, int64:
+		text = fmt.Sprintf("%d", a)
+	case uint, uint8, uint16, uint32, uint64:
+		text = fmt.Sprintf("%d", a)
+	case *big.Int:
+		if a == nil {
+			return "", fmt.Errorf("answer is a nil *big.Int")
+		}
+		text = a.String()
+	case string:
+		text = strings.TrimSpace(a)
+	case fmt.Stringer:
+		text = strings.TrimSpace(a.String())
+	case nil:
+		return "", fmt.Errorf("no answer returned")
+	default:
+		return "", fmt.Errorf("unsupported answer type %T", answer)
+	}
+
+	if text == "" {
+		return "", fmt.Errorf("answer is empty")
+	}
+	if strings.Contains(text, "\n") {
+		return "", fmt.Errorf("answer %q spans multiple lines", text)
+	}
+	return text, nil
+}
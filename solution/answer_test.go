@@ -0,0 +1,47 @@
+package solution_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/zMoooooritz/advent-of-code/solution"
+)
+
+func TestFormat(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	var nilBig *big.Int
+
+	tests := []struct {
+		name    string
+		answer  any
+		want    string
+		wantErr bool
+	}{
+		{"int", 42, "42", false},
+		{"negative int", -7, "-7", false},
+		{"int64", int64(1) << 62, "4611686018427387904", false},
+		{"uint64", uint64(1) << 63, "9223372036854775808", false},
+		{"big", huge, "123456789012345678901234567890", false},
+		{"string", "co,de,ka,ta", "co,de,ka,ta", false},
+		{"padded string", " 4,6,3\n", "4,6,3", false},
+		{"stringer", 90 * time.Second, "1m30s", false},
+		{"empty string", "", "", true},
+		{"multi-line string", "#.#\n.#.", "", true},
+		{"nil big", nilBig, "", true},
+		{"nil", nil, "", true},
+		{"float", 1.5, "", true},
+		{"slice", []int{1, 2}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := solution.Format(tt.answer)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Format() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Package solution defines the interface implemented by every day and the
+// registry the days add themselves to.
+package solution
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Solution solves both parts of a day. Parse is called once per run with the
+// puzzle input, the parts are solved on the parsed state afterwards. Answers
+// can be of any type Format accepts, usually int, int64, *big.Int or string.
+type Solution interface {
+	Parse(input string) error
+	Part1() any
+	Part2() any
+}
+
+// Constructor creates a fresh Solution for every run
+type Constructor func() Solution
+
+// Entry is a registered day
+type Entry struct {
+	Year int
+	Day  int
+	New  Constructor
+}
+
+func (e Entry) String() string {
+	return fmt.Sprintf("%d-day%02d", e.Year, e.Day)
+}
+
+// Registry maps days to their solutions
+type Registry struct {
+	mu   sync.Mutex
+	days map[[2]int]Constructor
+}
+
+func NewRegistry() *Registry {
+	return &Registry{days: map[[2]int]Constructor{}}
+}
+
+// registry holds the days registered by the init functions of their packages
+var registry = NewRegistry()
+
+// Register adds the solution of a day, it panics if the day is registered
+// twice
+func (r *Registry) Register(year, day int, new Constructor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := [2]int{year, day}
+	if _, ok := r.days[key]; ok {
+		panic(fmt.Sprintf("solution for %d-day%02d registered twice", year, day))
+	}
+	r.days[key] = new
+}
+
+// Get returns the solution of the given day
+func (r *Registry) Get(year, day int) (Constructor, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	new, ok := r.days[[2]int{year, day}]
+	return new, ok
+}
+
+// All returns every registered day ordered by year and day
+func (r *Registry) All() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entries := []Entry{}
+	for key, new := range r.days {
+		entries = append(entries, Entry{key[0], key[1], new})
+	}
+	slices.SortFunc(entries, func(a, b Entry) int {
+		if a.Year != b.Year {
+			return a.Year - b.Year
+		}
+		return a.Day - b.Day
+	})
+	return entries
+}
+
+// Register adds the solution of a day to the package registry, it is called
+// from the init function of the day package and panics if the day is
+// registered twice
+func Register(year, day int, new Constructor) {
+	registry.Register(year, day, new)
+}
+
+// Get returns the solution of the given day from the package registry
+func Get(year, day int) (Constructor, bool) {
+	return registry.Get(year, day)
+}
+
+// All returns every day of the package registry ordered by year and day
+func All() []Entry {
+	return registry.All()
+}
+
+// Solve parses the input with a new solution and returns the answer of the
+// given part
+func Solve(new Constructor, input string, part int) (any, error) {
+	s := new()
+	if err := s.Parse(input); err != nil {
+		return nil, fmt.Errorf("parsing input: %w", err)
+	}
+	switch part {
+	case 1:
+		return s.Part1(), nil
+	case 2:
+		return s.Part2(), nil
+	default:
+		return nil, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+// funcs is the Solution of days whose parts parse the raw input themselves
+type funcs[A, B any] struct {
+	input string
+	part1 func(string) A
+	part2 func(string) B
+}
+
+func (f *funcs[A, B]) Parse(input string) error {
+	f.input = input
+	return nil
+}
+
+func (f *funcs[A, B]) Part1() any {
+	return f.part1(f.input)
+}
+
+func (f *funcs[A, B]) Part2() any {
+	return f.part2(f.input)
+}
+
+// Funcs turns the part1 and part2 functions of a day into a Solution, both
+// are called with the raw input
+func Funcs[A, B any](part1 func(string) A, part2 func(string) B) Constructor {
+	return func() Solution {
+		return &funcs[A, B]{part1: part1, part2: part2}
+	}
+}
@@ -0,0 +1,89 @@
+package solution_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/solution"
+)
+
+// parsed parses its input once and solves both parts on the parsed state
+type parsed struct {
+	words []string
+}
+
+func (p *parsed) Parse(input string) error {
+	if input == "" {
+		return errors.New("empty input")
+	}
+	p.words = strings.Fields(input)
+	return nil
+}
+
+func (p *parsed) Part1() any { return len(p.words) }
+func (p *parsed) Part2() any { return strings.Join(p.words, ",") }
+
+func TestSolve(t *testing.T) {
+	funcs := solution.Funcs(
+		func(input string) int { return len(input) },
+		func(input string) string { return strings.ToUpper(input) },
+	)
+	withParse := func() solution.Solution { return &parsed{} }
+
+	tests := []struct {
+		name    string
+		new     solution.Constructor
+		input   string
+		part    int
+		want    any
+		wantErr bool
+	}{
+		{"funcs part 1", funcs, "abc", 1, 3, false},
+		{"funcs part 2", funcs, "abc", 2, "ABC", false},
+		{"parsed part 1", withParse, "a b c", 1, 3, false},
+		{"parsed part 2", withParse, "a b c", 2, "a,b,c", false},
+		{"parse error", withParse, "", 1, nil, true},
+		{"invalid part", funcs, "abc", 3, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := solution.Solve(tt.new, tt.input, tt.part)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Solve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Solve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	new := solution.Funcs(func(string) int { return 1 }, func(string) int { return 2 })
+	registry := solution.NewRegistry()
+	registry.Register(1999, 2, new)
+	registry.Register(1999, 1, new)
+
+	if _, ok := registry.Get(1999, 1); !ok {
+		t.Errorf("Get() did not find a registered day")
+	}
+	if _, ok := registry.Get(1999, 3); ok {
+		t.Errorf("Get() found a day that was not registered")
+	}
+
+	got := []string{}
+	for _, entry := range registry.All() {
+		got = append(got, entry.String())
+	}
+	if strings.Join(got, " ") != "1999-day01 1999-day02" {
+		t.Errorf("All() = %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register() of a duplicate day did not panic")
+		}
+	}()
+	registry.Register(1999, 1, new)
+}
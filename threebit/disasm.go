@@ -0,0 +1,50 @@
+package threebit
+
+import "fmt"
+
+func comboName(operand int) string {
+	switch operand {
+	case 0, 1, 2, 3:
+		return fmt.Sprint(operand)
+	case 4:
+		return "A"
+	case 5:
+		return "B"
+	case 6:
+		return "C"
+	default:
+		return "invalid"
+	}
+}
+
+// Disassemble turns the program into pseudo-code, one line per instruction
+// prefixed with its address
+func Disassemble(program []int) []string {
+	lines := []string{}
+	for address := 0; address+1 < len(program); address += 2 {
+		operand := program[address+1]
+		var code string
+		switch OpCode(program[address]) {
+		case ADV:
+			code = fmt.Sprintf("A = A >> %s", comboName(operand))
+		case BXL:
+			code = fmt.Sprintf("B = B ^ %d", operand)
+		case BST:
+			code = fmt.Sprintf("B = %s %% 8", comboName(operand))
+		case JNZ:
+			code = fmt.Sprintf("if A != 0 goto %d", operand)
+		case BXC:
+			code = "B = B ^ C"
+		case OUT:
+			code = fmt.Sprintf("out %s %% 8", comboName(operand))
+		case BDV:
+			code = fmt.Sprintf("B = A >> %s", comboName(operand))
+		case CDV:
+			code = fmt.Sprintf("C = A >> %s", comboName(operand))
+		default:
+			code = fmt.Sprintf("invalid %d %d", program[address], operand)
+		}
+		lines = append(lines, fmt.Sprintf("%2d: %s", address, code))
+	}
+	return lines
+}
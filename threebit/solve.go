@@ -0,0 +1,61 @@
+package threebit
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Shift returns the constant amount A is shifted by per loop, which requires
+// the program to contain exactly one ADV with a literal operand
+func Shift(program []int) (int, error) {
+	shift := -1
+	for address := 0; address+1 < len(program); address += 2 {
+		if OpCode(program[address]) != ADV {
+			continue
+		}
+		operand := program[address+1]
+		if operand > 3 || shift != -1 {
+			return 0, fmt.Errorf("A is not shifted by a single constant")
+		}
+		shift = operand
+	}
+	if shift <= 0 {
+		return 0, fmt.Errorf("A is never shifted")
+	}
+	return shift, nil
+}
+
+// SolveA finds the smallest A for which the program outputs target. Each
+// loop consumes the lowest bits of A, so A is built backwards from the last
+// output, shift bits at a time.
+func SolveA(program []int, b, c int, target []int) (int, error) {
+	shift, err := Shift(program)
+	if err != nil {
+		return 0, err
+	}
+
+	cpu := NewCPU(0, b, c, program)
+	var solve func(seed int, index int) (int, bool)
+	solve = func(seed int, index int) (int, bool) {
+		if index < 0 {
+			return seed, true
+		}
+		for low := range 1 << shift {
+			a := seed<<shift | low
+			cpu.Reset(a, b, c)
+			cpu.Run()
+			if !slices.Equal(cpu.Output(), target[index:]) {
+				continue
+			}
+			if result, ok := solve(a, index-1); ok {
+				return result, true
+			}
+		}
+		return 0, false
+	}
+
+	if result, ok := solve(0, len(target)-1); ok {
+		return result, nil
+	}
+	return 0, fmt.Errorf("no value of A outputs %v", target)
+}
@@ -0,0 +1,124 @@
+// Package threebit implements the 3-bit computer of AOC 2024 day 17.
+package threebit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zMoooooritz/advent-of-code/cast"
+)
+
+type OpCode int
+
+const (
+	ADV OpCode = iota
+	BXL
+	BST
+	JNZ
+	BXC
+	OUT
+	BDV
+	CDV
+)
+
+type CPU struct {
+	A int
+	B int
+	C int
+
+	instructionPointer int
+
+	Program []int
+	output  []int
+}
+
+func NewCPU(a, b, c int, program []int) *CPU {
+	return &CPU{A: a, B: b, C: c, Program: program}
+}
+
+// Parse reads the register and program listing of the puzzle input
+func Parse(input string) *CPU {
+	lines := strings.Split(input, "\n")
+	if len(lines) < 5 {
+		panic("expected three registers, a blank line and a program")
+	}
+	return NewCPU(
+		cast.ToInt(strings.TrimPrefix(lines[0], "Register A: ")),
+		cast.ToInt(strings.TrimPrefix(lines[1], "Register B: ")),
+		cast.ToInt(strings.TrimPrefix(lines[2], "Register C: ")),
+		cast.ToIntSliceSep(strings.TrimPrefix(lines[4], "Program: "), ","),
+	)
+}
+
+// Run executes the program until the instruction pointer leaves it and
+// returns the comma separated output
+func (c *CPU) Run() string {
+	for c.instructionPointer+1 < len(c.Program) {
+		c.step(OpCode(c.Program[c.instructionPointer]), c.Program[c.instructionPointer+1])
+	}
+	return c.String()
+}
+
+// Output returns the values written by OUT so far
+func (c *CPU) Output() []int {
+	return c.output
+}
+
+// String returns the output joined by commas
+func (c *CPU) String() string {
+	strOut := []string{}
+	for _, o := range c.output {
+		strOut = append(strOut, cast.ToString(o))
+	}
+	return strings.Join(strOut, ",")
+}
+
+// Reset restores the instruction pointer and output and sets new registers
+func (c *CPU) Reset(regA, regB, regC int) {
+	c.A, c.B, c.C = regA, regB, regC
+	c.instructionPointer = 0
+	c.output = c.output[:0]
+}
+
+func (c *CPU) step(opcode OpCode, operand int) {
+	switch opcode {
+	case ADV:
+		c.A = c.A >> c.combo(operand)
+	case BXL:
+		c.B = c.B ^ operand
+	case BST:
+		c.B = c.combo(operand) % 8
+	case JNZ:
+		if c.A != 0 {
+			c.instructionPointer = operand
+			return
+		}
+	case BXC:
+		c.B = c.B ^ c.C
+	case OUT:
+		c.output = append(c.output, c.combo(operand)%8)
+	case BDV:
+		c.B = c.A >> c.combo(operand)
+	case CDV:
+		c.C = c.A >> c.combo(operand)
+	default:
+		panic(fmt.Sprintf("invalid opcode %d", opcode))
+	}
+	c.instructionPointer += 2
+}
+
+// combo resolves the combo operand, 0-3 are literals and 4-6 the registers
+func (c *CPU) combo(operand int) int {
+	switch operand {
+	case 0, 1, 2, 3:
+		return operand
+	case 4:
+		return c.A
+	case 5:
+		return c.B
+	case 6:
+		return c.C
+	default:
+		panic(fmt.Sprintf("invalid combo operand %d", operand))
+	}
+}
@@ -0,0 +1,84 @@
+package threebit_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/zMoooooritz/advent-of-code/threebit"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		cpu     *threebit.CPU
+		want    string
+		wantReg [3]int
+	}{
+		{"bst", threebit.NewCPU(0, 0, 9, []int{2, 6}), "", [3]int{0, 1, 9}},
+		{"out", threebit.NewCPU(10, 0, 0, []int{5, 0, 5, 1, 5, 4}), "0,1,2", [3]int{10, 0, 0}},
+		{"loop", threebit.NewCPU(2024, 0, 0, []int{0, 1, 5, 4, 3, 0}), "4,2,5,6,7,7,7,7,3,1,0", [3]int{0, 0, 0}},
+		{"bxl", threebit.NewCPU(0, 29, 0, []int{1, 7}), "", [3]int{0, 26, 0}},
+		{"bxc", threebit.NewCPU(0, 2024, 43690, []int{4, 0}), "", [3]int{0, 44354, 43690}},
+		{"example", threebit.Parse("Register A: 729\nRegister B: 0\nRegister C: 0\n\nProgram: 0,1,5,4,3,0"), "4,6,3,5,6,3,5,2,1,0", [3]int{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cpu.Run(); got != tt.want {
+				t.Errorf("Run() = %q, want %q", got, tt.want)
+			}
+			if got := [3]int{tt.cpu.A, tt.cpu.B, tt.cpu.C}; got != tt.wantReg {
+				t.Errorf("registers = %v, want %v", got, tt.wantReg)
+			}
+		})
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+	program := []int{2, 4, 1, 2, 7, 5, 4, 1, 0, 3, 5, 5, 6, 6, 3, 0}
+	want := []string{
+		" 0: B = A % 8",
+		" 2: B = B ^ 2",
+		" 4: C = A >> B",
+		" 6: B = B ^ C",
+		" 8: A = A >> 3",
+		"10: out B % 8",
+		"12: B = A >> C",
+		"14: if A != 0 goto 0",
+	}
+	if got := threebit.Disassemble(program); !slices.Equal(got, want) {
+		t.Errorf("Disassemble() = %q, want %q", got, want)
+	}
+}
+
+func TestSolveA(t *testing.T) {
+	tests := []struct {
+		name    string
+		program []int
+		want    int
+		wantErr bool
+	}{
+		{"quine", []int{0, 3, 5, 4, 3, 0}, 117440, false},
+		{"xor", []int{2, 4, 1, 3, 7, 5, 0, 3, 4, 1, 5, 5, 3, 0}, 1371883853761, false},
+		{"no_shift", []int{5, 4, 3, 0}, 0, true},
+		{"register_shift", []int{0, 4, 5, 4, 3, 0}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := threebit.SolveA(tt.program, 0, 0, tt.program)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SolveA() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("SolveA() = %v, want %v", got, tt.want)
+			}
+			cpu := threebit.NewCPU(got, 0, 0, tt.program)
+			cpu.Run()
+			if !slices.Equal(cpu.Output(), tt.program) {
+				t.Errorf("program with A = %d outputs %v, want %v", got, cpu.Output(), tt.program)
+			}
+		})
+	}
+}